@@ -0,0 +1,106 @@
+package payment_plan
+
+import (
+	"fmt"
+	"math"
+)
+
+// Balloon is a residual amount of the principal paid together with the last installment.
+// Only one of Amount or Percentage (of Params.RequestedAmount, 0.3 meaning 30%) must be set.
+type Balloon struct {
+	Amount     float64
+	Percentage float64
+}
+
+// BalloonResponse is a Response of a plan with a balloon payment.
+//
+// The embedded Response InstallmentAmount is the regular installment, and the last installment is
+// InstallmentAmount + BalloonAmount. The contract amount, IOF, the amounts that depend on the installments and the
+// effective rates are recalculated for that cash flow, while the due dates and discount factors are the native ones.
+type BalloonResponse struct {
+	Response
+	BalloonAmount         float64
+	LastInstallmentAmount float64
+}
+
+// AmountFor returns the balloon amount for the given requested amount.
+func (b Balloon) AmountFor(requestedAmount float64) float64 {
+	if b.Amount > 0 {
+		return b.Amount
+	}
+	return roundCents(requestedAmount * b.Percentage)
+}
+
+// Validate checks that the balloon is smaller than the requested amount.
+func (b Balloon) Validate(requestedAmount float64) error {
+	if b.Amount < 0 || b.Percentage < 0 {
+		return fmt.Errorf("balloon can't be negative: %w", ErrInvalidParams)
+	}
+	if b.Amount > 0 && b.Percentage > 0 {
+		return fmt.Errorf("balloon must have either an amount or a percentage, not both: %w", ErrInvalidParams)
+	}
+	if b.AmountFor(requestedAmount) >= requestedAmount {
+		return fmt.Errorf("balloon %v must be lower than the requested amount %v: %w", b.AmountFor(requestedAmount), requestedAmount, ErrInvalidParams)
+	}
+	return nil
+}
+
+// CalculateBalloonPaymentPlan calculates a payment plan where part of the principal is paid as a larger final installment.
+//
+// The regular installment is the one that, together with the balloon, pays off the contract amount at the discount factors (DaysIndex)
+// calculated by the native library. The contract amount and IOF are recalculated the way the native library calculates its plans,
+// since the balloon amortizes the principal later than the regular installments would.
+func CalculateBalloonPaymentPlan(params Params, balloon Balloon) ([]BalloonResponse, error) {
+	if err := balloon.Validate(params.RequestedAmount); err != nil {
+		return nil, err
+	}
+
+	response, err := CalculatePaymentPlan(params)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := nativeSchedule(response)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := balloon.AmountFor(params.RequestedAmount)
+	result := make([]BalloonResponse, len(response))
+	for i, r := range response {
+		result[i] = withBalloon(params, r, amount, schedule[:r.Installment])
+	}
+	return result, nil
+}
+
+func withBalloon(params Params, r Response, amount float64, schedule []scheduledDate) BalloonResponse {
+	n := len(schedule)
+	flow := cashFlow{
+		schedule: schedule,
+		installments: func(contractAmount float64) []float64 {
+			installment := (contractAmount - amount*schedule[n-1].discountFactor) / accumulatedDiscountFactor(schedule)
+			installments := make([]float64, n)
+			for k := range installments {
+				installments[k] = installment
+			}
+			installments[n-1] += amount
+			return installments
+		},
+	}
+	r, installments := flow.recalculate(params, r)
+	if n == 1 {
+		// The balloon is paid with the only installment, so the regular installment is what is left of it.
+		r.InstallmentAmount = roundCents(r.InstallmentAmount - amount)
+		r.CustomerAmount = roundCents(r.CustomerAmount - amount)
+	}
+
+	return BalloonResponse{
+		Response:              r,
+		BalloonAmount:         amount,
+		LastInstallmentAmount: installments[n-1],
+	}
+}
+
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
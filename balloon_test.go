@@ -0,0 +1,64 @@
+package payment_plan_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+func TestCalculateBalloonPaymentPlan(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+
+	plain, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+
+	resp, err := payment_plan.CalculateBalloonPaymentPlan(params, payment_plan.Balloon{Percentage: 0.3})
+	if err != nil {
+		t.Fatalf("Error calculating balloon payment plan: %v", err)
+	}
+
+	if len(resp) != len(plain) {
+		t.Fatalf("Expected %d plans, got %d", len(plain), len(resp))
+	}
+	for i, r := range resp {
+		if r.BalloonAmount != 2340 {
+			t.Errorf("Installment %d: Expected BalloonAmount %v, got %v", i+1, 2340, r.BalloonAmount)
+		}
+		if r.LastInstallmentAmount != math.Round((r.InstallmentAmount+r.BalloonAmount)*100)/100 {
+			t.Errorf("Installment %d: Expected LastInstallmentAmount %v, got %v", i+1, r.InstallmentAmount+r.BalloonAmount, r.LastInstallmentAmount)
+		}
+		if math.Abs(r.TotalAmount-(r.InstallmentAmount*float64(r.Installment)+r.BalloonAmount)) > 1e-9 {
+			t.Errorf("Installment %d: Expected TotalAmount %v, got %v", i+1, r.InstallmentAmount*float64(r.Installment)+r.BalloonAmount, r.TotalAmount)
+		}
+		// The due dates and discount factors are the native ones.
+		if !r.DueDate.Equal(plain[i].DueDate) || r.DaysIndex != plain[i].DaysIndex || r.AccumulatedDaysIndex != plain[i].AccumulatedDaysIndex {
+			t.Errorf("Installment %d: Expected the schedule of the plain plan, got DueDate %v, DaysIndex %v and AccumulatedDaysIndex %v",
+				i+1, r.DueDate, r.DaysIndex, r.AccumulatedDaysIndex)
+		}
+	}
+
+	// A single installment pays the balloon too, so it is the plain 1x.
+	if r := resp[0]; r.LastInstallmentAmount != plain[0].InstallmentAmount || r.InstallmentAmount != plain[0].InstallmentAmount-2340 || r.TotalIof != plain[0].TotalIof {
+		t.Errorf("Expected 1x of %v with the TotalIof %v of the plain 1x, got %v and %v", plain[0].InstallmentAmount, plain[0].TotalIof, r.LastInstallmentAmount, r.TotalIof)
+	}
+
+	// The balloon amortizes 30% of the principal on the last due date, so the IOF counts more days than in the plain 4x (77.36).
+	four := resp[3]
+	if four.InstallmentAmount != 1515.74 || four.LastInstallmentAmount != 3855.74 || four.TotalAmount != 8402.96 {
+		t.Errorf("Expected 4x of 1515.74, the last of 3855.74 and TotalAmount 8402.96, got %v, %v and %v", four.InstallmentAmount, four.LastInstallmentAmount, four.TotalAmount)
+	}
+	if four.TotalIof != 86.14 || four.ContractAmount != 7886.14 || four.EirMonthly != 0.0229 || four.TecMonthly != 0.027 {
+		t.Errorf("Expected 4x with TotalIof 86.14, ContractAmount 7886.14, EirMonthly 0.0229 and TecMonthly 0.027, got %v, %v, %v and %v",
+			four.TotalIof, four.ContractAmount, four.EirMonthly, four.TecMonthly)
+	}
+
+	_, err = payment_plan.CalculateBalloonPaymentPlan(params, payment_plan.Balloon{Amount: 7800})
+	if !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams, got %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+package payment_plan_test
+
+import (
+	"fmt"
+	"testing"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+// The benchmarks measure a call through the FFI, the calculation of the native library included, and report its allocations:
+//
+//	go test -run '^$' -bench . -benchmem
+//
+// Most of the allocations are the responses themselves, the lowering of the params and lifting of the responses
+// borrow their buffers from pools.
+
+func BenchmarkCalculatePaymentPlan(b *testing.B) {
+	for _, installments := range []uint32{1, 4, 12, 24} {
+		params := payment_plantest.NewParams().Amount(25000).Installments(installments).Build()
+		b.Run(fmt.Sprintf("installments=%d", installments), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := payment_plan.CalculatePaymentPlan(params); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkCalculateDownPaymentPlan(b *testing.B) {
+	base := payment_plantest.NewParams().Amount(25000)
+	for _, grid := range []struct{ downPayments, installments uint32 }{{1, 4}, {4, 12}, {12, 24}} {
+		params := base.Installments(grid.installments).DownPayment(5000, grid.downPayments)
+		b.Run(fmt.Sprintf("down_payments=%d/installments=%d", grid.downPayments, grid.installments), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := payment_plan.CalculateDownPaymentPlan(params); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGetNonBusinessDaysBetween(b *testing.B) {
+	start, end := payment_plantest.Date(2025, 01, 1), payment_plantest.Date(2025, 12, 31)
+	b.ReportAllocs()
+	for b.Loop() {
+		payment_plan.GetNonBusinessDaysBetween(start, end)
+	}
+}
@@ -0,0 +1,158 @@
+package payment_plan
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"math"
+	"time"
+)
+
+// DefaultCacheSize is the number of plans kept by WithCache when its size is not positive.
+const DefaultCacheSize = 1024
+
+// CacheOptions configure the cache of a Calculator.
+type CacheOptions struct {
+	// Size is the number of plans kept, the least recently used ones are evicted beyond it. DefaultCacheSize if not positive.
+	Size int
+	// TTL is how long a plan is kept after it is calculated. Plans don't expire if it is not positive.
+	TTL time.Duration
+}
+
+// CacheStats are the counters of the cache of a Calculator, since it was created.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64 // plans dropped for the size of the cache or their TTL
+	// Invalidations counts the calls of InvalidateCalendar, each one drops every plan.
+	Invalidations uint64
+	Entries       int
+}
+
+// cacheKey is the SHA-256 of the canonical encoding of the params of a plan.
+type cacheKey [sha256.Size]byte
+
+type cacheEntry struct {
+	key     cacheKey
+	value   any
+	expires time.Time
+}
+
+// lru is a least recently used cache with expiration. It is not safe for concurrent use, the Calculator locks it.
+type lru struct {
+	size    int
+	ttl     time.Duration
+	entries map[cacheKey]*list.Element
+	order   *list.List // most recently used first
+	stats   CacheStats
+}
+
+func newLRU(options CacheOptions) *lru {
+	if options.Size <= 0 {
+		options.Size = DefaultCacheSize
+	}
+	return &lru{size: options.Size, ttl: options.TTL, entries: map[cacheKey]*list.Element{}, order: list.New()}
+}
+
+func (c *lru) get(key cacheKey, now time.Time) (any, bool) {
+	element, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+	entry := element.Value.(*cacheEntry)
+	if c.ttl > 0 && !now.Before(entry.expires) {
+		c.remove(element)
+		c.stats.Evictions++
+		c.stats.Misses++
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	c.stats.Hits++
+	return entry.value, true
+}
+
+func (c *lru) put(key cacheKey, value any, now time.Time) {
+	if element, ok := c.entries[key]; ok {
+		c.remove(element)
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, value: value, expires: now.Add(c.ttl)})
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+		c.stats.Evictions++
+	}
+}
+
+func (c *lru) remove(element *list.Element) {
+	delete(c.entries, element.Value.(*cacheEntry).key)
+	c.order.Remove(element)
+}
+
+func (c *lru) clear() {
+	clear(c.entries)
+	c.order.Init()
+}
+
+// keyEncoder writes the canonical encoding of params to a hash: numbers in big endian, with -0 as 0,
+// and dates as the day in the -03 time zone of the native library.
+type keyEncoder struct {
+	hash hash.Hash
+	buf  []byte
+}
+
+func newKeyEncoder(kind byte) *keyEncoder {
+	e := &keyEncoder{hash: sha256.New()}
+	e.buf = append(e.buf, kind)
+	return e
+}
+
+func (e *keyEncoder) uint(v uint64) {
+	e.buf = binary.BigEndian.AppendUint64(e.buf, v)
+}
+
+func (e *keyEncoder) float(v float64) {
+	if v == 0 {
+		v = 0
+	}
+	e.uint(math.Float64bits(v))
+}
+
+func (e *keyEncoder) date(t time.Time) {
+	year, month, day := t.In(nativeLocation).Date()
+	e.uint(uint64(year)<<16 | uint64(month)<<8 | uint64(day))
+}
+
+func (e *keyEncoder) bool(v bool) {
+	if v {
+		e.buf = append(e.buf, 1)
+	} else {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+func (e *keyEncoder) params(params Params, requestedDate time.Time) {
+	e.float(params.RequestedAmount)
+	e.date(params.FirstPaymentDate)
+	e.date(requestedDate)
+	e.uint(uint64(params.Installments))
+	e.uint(uint64(params.DebitServicePercentage))
+	e.float(params.Mdr)
+	e.float(params.TacPercentage)
+	e.float(params.IofOverall)
+	e.float(params.IofPercentage)
+	e.float(params.InterestRate)
+	e.float(params.MinInstallmentAmount)
+	e.float(params.MaxTotalAmount)
+	e.bool(params.DisbursementOnlyOnBusinessDays)
+}
+
+func (e *keyEncoder) sum() cacheKey {
+	var key cacheKey
+	e.hash.Write(e.buf)
+	e.hash.Sum(key[:0])
+	return key
+}
+
+// nativeLocation is the -03 time zone in which the native library takes the day of the dates.
+var nativeLocation = time.FixedZone("-03", -3*60*60)
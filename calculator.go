@@ -0,0 +1,380 @@
+package payment_plan
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Product holds the params that are the same for every plan of a credit product, the ones a service doesn't get from its customer.
+type Product struct {
+	DebitServicePercentage         uint16
+	Mdr                            float64
+	TacPercentage                  float64
+	IofOverall                     float64
+	IofPercentage                  float64
+	InterestRate                   float64
+	MinInstallmentAmount           float64
+	MaxTotalAmount                 float64
+	DisbursementOnlyOnBusinessDays bool
+}
+
+// DefaultProduct is the product of a Calculator without WithProduct: the IOF of loans to individuals, 0.38% plus 0.0082% a day,
+// no interest, fees or limits, paid by the customer and disbursed on business days.
+var DefaultProduct = Product{
+	IofOverall:                     0.0038,
+	IofPercentage:                  0.000082,
+	MaxTotalAmount:                 math.MaxFloat64,
+	DisbursementOnlyOnBusinessDays: true,
+}
+
+// Params returns the params of a plan of the product.
+func (p Product) Params(requestedAmount float64, installments uint32, requestedDate time.Time, firstPaymentDate time.Time) Params {
+	return Params{
+		RequestedAmount:                requestedAmount,
+		FirstPaymentDate:               firstPaymentDate,
+		RequestedDate:                  requestedDate,
+		Installments:                   installments,
+		DebitServicePercentage:         p.DebitServicePercentage,
+		Mdr:                            p.Mdr,
+		TacPercentage:                  p.TacPercentage,
+		IofOverall:                     p.IofOverall,
+		IofPercentage:                  p.IofPercentage,
+		InterestRate:                   p.InterestRate,
+		MinInstallmentAmount:           p.MinInstallmentAmount,
+		MaxTotalAmount:                 p.MaxTotalAmount,
+		DisbursementOnlyOnBusinessDays: p.DisbursementOnlyOnBusinessDays,
+	}
+}
+
+// Calendar holds the business day functions of a Calculator. Nil fields are the functions of the package.
+//
+// The plans are calculated by the native library on its own business days. NonBusinessDaysBetween also keys the cache,
+// so it should have the same business days, e.g. a copy of them recorded for tests.
+type Calendar struct {
+	NextDisbursementDate   func(baseDate time.Time) time.Time
+	DisbursementDateRange  func(baseDate time.Time, days uint32) (time.Time, time.Time)
+	NonBusinessDaysBetween func(startDate time.Time, endDate time.Time) []time.Time
+}
+
+// CalculationInfo describes a calculation of a Calculator to its Hooks.
+type CalculationInfo struct {
+	Cached   bool
+	Duration time.Duration
+	Err      error
+}
+
+// Hooks are called by a Calculator after each calculation, e.g. to record metrics or logs. Nil hooks are not called.
+// They get the params as calculated, after overrides and rounding, and must not change the response.
+type Hooks struct {
+	PaymentPlan     func(params Params, response []Response, info CalculationInfo)
+	DownPaymentPlan func(params DownPaymentParams, response []DownPaymentResponse, info CalculationInfo)
+}
+
+// Override changes the params of a single call of Calculator.Params or Calculator.PaymentPlan, e.g. a promotional interest rate:
+//
+//	promotion := func(p *payment_plan.Params) { p.InterestRate = 0.0099 }
+//	response, err := c.PaymentPlan(1500, 12, firstPaymentDate, promotion)
+type Override func(*Params)
+
+// Calculator calculates plans like the functions of the package, with the params of a product (see WithProduct)
+// and optionally keeping them in a cache (see WithCache), so the plans of the same params, e.g. of a price shown
+// to each visitor of a checkout page, are calculated once.
+//
+// The cache is keyed by a hash of the params in which the dates are the day in the -03 time zone of the native library,
+// and the requested date is moved to the next business day of the calendar of the Calculator when the disbursement only happens
+// on business days, so params requested on a Saturday and on the following Monday share their plans. It is not moved with
+// NextDisbursementDate, which also skips the day it is called on, so the key of the same params doesn't change with the day.
+// The first payment date is not moved, the next due dates are months after it and not after its business day.
+//
+// A Calculator is safe for concurrent use. Two calls with the same params at the same time may both calculate the plans.
+type Calculator struct {
+	product  Product
+	calendar Calendar
+	now      func() time.Time
+	round    func(float64) float64 // nil without WithRounding
+	hooks    Hooks
+
+	mu         sync.Mutex
+	cache      *lru   // nil without WithCache
+	generation uint64 // changed by InvalidateCalendar, so plans calculated before it are not cached
+
+	paymentPlan     func(Params) ([]Response, error)
+	downPaymentPlan func(DownPaymentParams) ([]DownPaymentResponse, error)
+}
+
+// Option configures a Calculator.
+type Option func(*Calculator)
+
+// WithCache keeps the plans in a least recently used cache with the options.
+func WithCache(options CacheOptions) Option {
+	return func(c *Calculator) {
+		c.cache = newLRU(options)
+	}
+}
+
+// WithProduct sets the product of the params built by the Calculator, instead of DefaultProduct.
+func WithProduct(product Product) Option {
+	return func(c *Calculator) {
+		c.product = product
+	}
+}
+
+// WithCalendar sets the business day functions of the Calculator. Nil fields keep the functions of the package.
+func WithCalendar(calendar Calendar) Option {
+	return func(c *Calculator) {
+		if calendar.NextDisbursementDate != nil {
+			c.calendar.NextDisbursementDate = calendar.NextDisbursementDate
+		}
+		if calendar.DisbursementDateRange != nil {
+			c.calendar.DisbursementDateRange = calendar.DisbursementDateRange
+		}
+		if calendar.NonBusinessDaysBetween != nil {
+			c.calendar.NonBusinessDaysBetween = calendar.NonBusinessDaysBetween
+		}
+	}
+}
+
+// WithClock sets the clock of the requested date of the params built by the Calculator and of the expiration of its cache,
+// time.Now by default.
+func WithClock(now func() time.Time) Option {
+	return func(c *Calculator) {
+		c.now = now
+	}
+}
+
+// WithRounding rounds the amounts of the params to the decimal places before calculating, so amounts computed from prices,
+// e.g. 7799.999999999999, are calculated, and cached, as the amount they stand for. Rates are not rounded.
+// Amounts above maxRoundedAmount, e.g. the math.MaxFloat64 of no limit of DefaultProduct, are kept as they are.
+func WithRounding(places int) Option {
+	return func(c *Calculator) {
+		c.round = func(v float64) float64 {
+			if math.Abs(v) > maxRoundedAmount {
+				return v
+			}
+			return roundTo(v, places)
+		}
+	}
+}
+
+// maxRoundedAmount is the largest amount rounded by WithRounding. Above it a float64 has no cents to round,
+// and scaling it to the decimal places could overflow to infinity.
+const maxRoundedAmount = 1e15
+
+// WithHooks sets the hooks called after each calculation of the Calculator.
+func WithHooks(hooks Hooks) Option {
+	return func(c *Calculator) {
+		c.hooks = hooks
+	}
+}
+
+// NewCalculator returns a Calculator with the options. Without options it calculates the params it is given
+// with the functions of the package, and builds params of DefaultProduct.
+func NewCalculator(options ...Option) *Calculator {
+	c := &Calculator{
+		product: DefaultProduct,
+		calendar: Calendar{
+			NextDisbursementDate:   NextDisbursementDate,
+			DisbursementDateRange:  DisbursementDateRange,
+			NonBusinessDaysBetween: GetNonBusinessDaysBetween,
+		},
+		now:             time.Now,
+		paymentPlan:     CalculatePaymentPlan,
+		downPaymentPlan: CalculateDownPaymentPlan,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// Params returns the params of the product of the Calculator for the requested amount in up to installments,
+// requested now by the clock of the Calculator, with the overrides applied in order.
+func (c *Calculator) Params(requestedAmount float64, installments uint32, firstPaymentDate time.Time, overrides ...Override) Params {
+	params := c.product.Params(requestedAmount, installments, c.now(), firstPaymentDate)
+	for _, override := range overrides {
+		override(&params)
+	}
+	return params
+}
+
+// PaymentPlan calculates the plans of the Params of the Calculator.
+func (c *Calculator) PaymentPlan(requestedAmount float64, installments uint32, firstPaymentDate time.Time, overrides ...Override) ([]Response, error) {
+	return c.CalculatePaymentPlan(c.Params(requestedAmount, installments, firstPaymentDate, overrides...))
+}
+
+// CalculatePaymentPlan is CalculatePaymentPlan of the package, with the rounding, cache and hooks of the Calculator.
+// The params are calculated as they are, the product of the Calculator is only used by Params.
+// The response is the caller's, changing it doesn't change the cached plans. Errors are not cached.
+func (c *Calculator) CalculatePaymentPlan(params Params) ([]Response, error) {
+	params = c.roundParams(params)
+	start := time.Now()
+	response, cached, err := c.cachedPaymentPlan(params)
+	if c.hooks.PaymentPlan != nil {
+		c.hooks.PaymentPlan(params, response, CalculationInfo{Cached: cached, Duration: time.Since(start), Err: err})
+	}
+	return response, err
+}
+
+// CalculateDownPaymentPlan is CalculateDownPaymentPlan of the package, with the rounding, cache and hooks of the Calculator.
+// The response is the caller's, changing it doesn't change the cached plans. Errors are not cached.
+func (c *Calculator) CalculateDownPaymentPlan(params DownPaymentParams) ([]DownPaymentResponse, error) {
+	params.Params = c.roundParams(params.Params)
+	if c.round != nil {
+		params.RequestedAmount = c.round(params.RequestedAmount)
+		params.MinInstallmentAmount = c.round(params.MinInstallmentAmount)
+	}
+	start := time.Now()
+	response, cached, err := c.cachedDownPaymentPlan(params)
+	if c.hooks.DownPaymentPlan != nil {
+		c.hooks.DownPaymentPlan(params, response, CalculationInfo{Cached: cached, Duration: time.Since(start), Err: err})
+	}
+	return response, err
+}
+
+// NextDisbursementDate is NextDisbursementDate of the package, on the calendar of the Calculator.
+func (c *Calculator) NextDisbursementDate(baseDate time.Time) time.Time {
+	return c.calendar.NextDisbursementDate(baseDate)
+}
+
+// DisbursementDateRange is DisbursementDateRange of the package, on the calendar of the Calculator.
+func (c *Calculator) DisbursementDateRange(baseDate time.Time, days uint32) (time.Time, time.Time) {
+	return c.calendar.DisbursementDateRange(baseDate, days)
+}
+
+// GetNonBusinessDaysBetween is GetNonBusinessDaysBetween of the package, on the calendar of the Calculator.
+func (c *Calculator) GetNonBusinessDaysBetween(startDate time.Time, endDate time.Time) []time.Time {
+	return c.calendar.NonBusinessDaysBetween(startDate, endDate)
+}
+
+// InvalidateCalendar drops the cached plans. Call it when the holiday calendar changes,
+// e.g. after loading a new version of the native library, as plans depend on the business days of their dates.
+func (c *Calculator) InvalidateCalendar() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	if c.cache != nil {
+		c.cache.clear()
+		c.cache.stats.Invalidations++
+	}
+}
+
+// Stats returns the counters of the cache. They are zero without WithCache.
+func (c *Calculator) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	stats := c.cache.stats
+	stats.Entries = c.cache.order.Len()
+	return stats
+}
+
+func (c *Calculator) roundParams(params Params) Params {
+	if c.round != nil {
+		params.RequestedAmount = c.round(params.RequestedAmount)
+		params.MinInstallmentAmount = c.round(params.MinInstallmentAmount)
+		params.MaxTotalAmount = c.round(params.MaxTotalAmount)
+	}
+	return params
+}
+
+func (c *Calculator) cachedPaymentPlan(params Params) ([]Response, bool, error) {
+	if c.cache == nil {
+		response, err := c.paymentPlan(params)
+		return response, false, err
+	}
+	key := c.paymentPlanKey(params)
+	c.mu.Lock()
+	value, generation, ok := c.get(key)
+	c.mu.Unlock()
+	if ok {
+		return slices.Clone(value.([]Response)), true, nil
+	}
+
+	response, err := c.paymentPlan(params)
+	if err != nil {
+		return nil, false, err
+	}
+	c.put(key, generation, slices.Clone(response))
+	return response, false, nil
+}
+
+func (c *Calculator) cachedDownPaymentPlan(params DownPaymentParams) ([]DownPaymentResponse, bool, error) {
+	if c.cache == nil {
+		response, err := c.downPaymentPlan(params)
+		return response, false, err
+	}
+	key := c.downPaymentPlanKey(params)
+	c.mu.Lock()
+	value, generation, ok := c.get(key)
+	c.mu.Unlock()
+	if ok {
+		return cloneDownPaymentResponses(value.([]DownPaymentResponse)), true, nil
+	}
+
+	response, err := c.downPaymentPlan(params)
+	if err != nil {
+		return nil, false, err
+	}
+	c.put(key, generation, cloneDownPaymentResponses(response))
+	return response, false, nil
+}
+
+// get looks the key up in the cache, with the generation of the calendar to cache the plans of a miss with. c.mu must be held.
+func (c *Calculator) get(key cacheKey) (any, uint64, bool) {
+	value, ok := c.cache.get(key, c.now())
+	return value, c.generation, ok
+}
+
+// put caches the plans of the key, unless the calendar changed while they were calculated.
+func (c *Calculator) put(key cacheKey, generation uint64, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation == c.generation {
+		c.cache.put(key, value, c.now())
+	}
+}
+
+// paymentPlanKey returns the key of the params. It calls the calendar, so it is called without holding c.mu.
+func (c *Calculator) paymentPlanKey(params Params) cacheKey {
+	e := newKeyEncoder('p')
+	e.params(params, c.requestedDate(params))
+	return e.sum()
+}
+
+// downPaymentPlanKey returns the key of the params. It calls the calendar, so it is called without holding c.mu.
+func (c *Calculator) downPaymentPlanKey(params DownPaymentParams) cacheKey {
+	e := newKeyEncoder('d')
+	e.params(params.Params, c.requestedDate(params.Params))
+	e.float(params.RequestedAmount)
+	e.float(params.MinInstallmentAmount)
+	e.date(params.FirstPaymentDate)
+	e.uint(uint64(params.Installments))
+	return e.sum()
+}
+
+// requestedDate returns the requested date of the key of the params: the requested date, or the first business day
+// on or after it when the disbursement only happens on business days.
+func (c *Calculator) requestedDate(params Params) time.Time {
+	if !params.DisbursementOnlyOnBusinessDays {
+		return params.RequestedDate
+	}
+	// Two weeks hold a business day even around Carnival and Christmas.
+	date := params.RequestedDate.In(nativeLocation)
+	nonBusinessDays := dateSet(c.calendar.NonBusinessDaysBetween(date, date.AddDate(0, 0, 14)))
+	for nonBusinessDays[toCivilDate(date)] {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+func cloneDownPaymentResponses(response []DownPaymentResponse) []DownPaymentResponse {
+	clone := slices.Clone(response)
+	for i := range clone {
+		clone[i].Plans = slices.Clone(clone[i].Plans)
+	}
+	return clone
+}
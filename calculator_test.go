@@ -0,0 +1,309 @@
+package payment_plan_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+func checkStats(t *testing.T, c *payment_plan.Calculator, expected payment_plan.CacheStats) {
+	t.Helper()
+	if stats := c.Stats(); stats != expected {
+		t.Errorf("Expected stats %+v, got %+v", expected, stats)
+	}
+}
+
+func TestCalculatorCache(t *testing.T) {
+	c := payment_plan.NewCalculator(payment_plan.WithCache(payment_plan.CacheOptions{}))
+	params := payment_plantest.NewParams().Build()
+
+	first, err := c.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	expected, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	payment_plantest.AssertResponsesEqual(t, first, expected, payment_plantest.Tolerance{})
+
+	// The cached plans are not the ones returned, so changing them doesn't change the next response.
+	first[0].InstallmentAmount = 0
+	second, err := c.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	payment_plantest.AssertResponsesEqual(t, second, expected, payment_plantest.Tolerance{})
+	checkStats(t, c, payment_plan.CacheStats{Hits: 1, Misses: 1, Entries: 1})
+}
+
+func TestCalculatorDownPaymentCache(t *testing.T) {
+	c := payment_plan.NewCalculator(payment_plan.WithCache(payment_plan.CacheOptions{}))
+	params := payment_plantest.NewParams().DownPayment(1000, 4)
+
+	first, err := c.CalculateDownPaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating down payment plan: %v", err)
+	}
+	first[0].Plans[0].InstallmentAmount = 0
+	second, err := c.CalculateDownPaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating down payment plan: %v", err)
+	}
+	expected, err := payment_plan.CalculateDownPaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating down payment plan: %v", err)
+	}
+	payment_plantest.AssertDownPaymentResponsesEqual(t, second, expected, payment_plantest.Tolerance{})
+	checkStats(t, c, payment_plan.CacheStats{Hits: 1, Misses: 1, Entries: 1})
+}
+
+func TestCalculatorCacheKey(t *testing.T) {
+	base := payment_plantest.NewParams()
+	saturday := payment_plantest.Date(2025, 04, 5)
+	monday := payment_plantest.Date(2025, 04, 7)
+	firstPayment := payment_plantest.Date(2025, 05, 3)
+
+	tests := []struct {
+		name   string
+		params payment_plan.Params
+		hit    bool
+	}{
+		// 2025-04-05 is a Saturday, the disbursement is on Monday 2025-04-07 either way.
+		{"next business day", base.Dates(monday, firstPayment).Build(), true},
+		{"time of the day", base.Dates(saturday.Add(15*time.Hour).UTC(), firstPayment.Add(20*time.Hour)).Build(), true},
+		{"negative zero", base.Tac(math.Copysign(0, -1)).Build(), true},
+		{"other day", base.Dates(saturday.AddDate(0, 0, 3), firstPayment).Build(), false},
+		{"business day before", base.Dates(saturday.AddDate(0, 0, -1), firstPayment).Build(), false},
+		{"first payment on a business day", base.Dates(saturday, payment_plantest.Date(2025, 05, 5)).Build(), false},
+		{"disbursement on any day", base.BusinessDaysOnly(false).Build(), false},
+		{"other amount", base.Amount(7800.01).Build(), false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := payment_plan.NewCalculator(payment_plan.WithCache(payment_plan.CacheOptions{}))
+			if _, err := c.CalculatePaymentPlan(base.Dates(saturday, firstPayment).Build()); err != nil {
+				t.Fatalf("Error calculating payment plan: %v", err)
+			}
+			if _, err := c.CalculatePaymentPlan(test.params); err != nil {
+				t.Fatalf("Error calculating payment plan: %v", err)
+			}
+			if hit := c.Stats().Hits == 1; hit != test.hit {
+				t.Errorf("Expected a hit %v for %+v, got %+v", test.hit, test.params, c.Stats())
+			}
+		})
+	}
+}
+
+func TestCalculatorCacheEviction(t *testing.T) {
+	c := payment_plan.NewCalculator(payment_plan.WithCache(payment_plan.CacheOptions{Size: 2}))
+	base := payment_plantest.NewParams()
+	for _, installments := range []uint32{1, 2, 1, 3, 2, 1} {
+		if _, err := c.CalculatePaymentPlan(base.Installments(installments).Build()); err != nil {
+			t.Fatalf("Error calculating payment plan: %v", err)
+		}
+	}
+	// 1 is used again before 3 is cached, so 3 evicts 2, then 2 evicts 1 and 1 evicts 3.
+	checkStats(t, c, payment_plan.CacheStats{Hits: 1, Misses: 5, Evictions: 3, Entries: 2})
+}
+
+func TestCalculatorCacheTTL(t *testing.T) {
+	now := payment_plantest.Date(2025, 04, 5)
+	c := payment_plan.NewCalculator(
+		payment_plan.WithCache(payment_plan.CacheOptions{TTL: time.Minute}),
+		payment_plan.WithClock(func() time.Time { return now }),
+	)
+	params := payment_plantest.NewParams().Build()
+	for _, elapsed := range []time.Duration{0, 59 * time.Second, time.Minute} {
+		now = now.Add(elapsed)
+		if _, err := c.CalculatePaymentPlan(params); err != nil {
+			t.Fatalf("Error calculating payment plan: %v", err)
+		}
+	}
+	// The plans are cached at 0 and hit at 59s, and expire at 1m59s, a minute after they were cached.
+	checkStats(t, c, payment_plan.CacheStats{Hits: 1, Misses: 2, Evictions: 1, Entries: 1})
+}
+
+func TestCalculatorInvalidateCalendar(t *testing.T) {
+	c := payment_plan.NewCalculator(payment_plan.WithCache(payment_plan.CacheOptions{}))
+	params := payment_plantest.NewParams().Build()
+	if _, err := c.CalculatePaymentPlan(params); err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	c.InvalidateCalendar()
+	checkStats(t, c, payment_plan.CacheStats{Misses: 1, Invalidations: 1})
+
+	if _, err := c.CalculatePaymentPlan(params); err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	checkStats(t, c, payment_plan.CacheStats{Misses: 2, Invalidations: 1, Entries: 1})
+}
+
+func TestCalculatorWithoutCache(t *testing.T) {
+	c := payment_plan.NewCalculator()
+	params := payment_plantest.NewParams().Build()
+	for range 2 {
+		if _, err := c.CalculatePaymentPlan(params); err != nil {
+			t.Fatalf("Error calculating payment plan: %v", err)
+		}
+	}
+	checkStats(t, c, payment_plan.CacheStats{})
+
+	// Errors are returned as they are, and not cached.
+	if _, err := c.CalculatePaymentPlan(payment_plantest.NewParams().Installments(0).Build()); err == nil {
+		t.Error("Expected an error for 0 installments")
+	}
+}
+
+var product = payment_plan.Product{
+	DebitServicePercentage:         50,
+	Mdr:                            0.05,
+	IofOverall:                     0.0038,
+	IofPercentage:                  0.000082,
+	InterestRate:                   0.0235,
+	MinInstallmentAmount:           100,
+	MaxTotalAmount:                 1000000,
+	DisbursementOnlyOnBusinessDays: true,
+}
+
+func TestCalculatorParams(t *testing.T) {
+	now := time.Date(2025, 04, 5, 10, 30, 0, 0, payment_plantest.Location)
+	c := payment_plan.NewCalculator(payment_plan.WithProduct(product), payment_plan.WithClock(func() time.Time { return now }))
+	firstPayment := payment_plantest.Date(2025, 05, 3)
+
+	params := c.Params(7800, 4, firstPayment, func(p *payment_plan.Params) { p.InterestRate = 0.0099 })
+	expected := payment_plantest.NewParams().MerchantPays(50).InterestRate(0.0099).Dates(now, firstPayment).Build()
+	if params != expected {
+		t.Errorf("Expected params %+v, got %+v", expected, params)
+	}
+
+	response, err := c.PaymentPlan(7800, 4, firstPayment)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	expectedResponse, err := payment_plan.CalculatePaymentPlan(product.Params(7800, 4, now, firstPayment))
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	payment_plantest.AssertResponsesEqual(t, response, expectedResponse, payment_plantest.Tolerance{})
+}
+
+func TestCalculatorDefaultProduct(t *testing.T) {
+	params := payment_plan.NewCalculator().Params(7800, 4, payment_plantest.Date(2025, 05, 3))
+	if err := payment_plan.ValidateParams(params); err != nil {
+		t.Errorf("Expected valid params of DefaultProduct, got %v", err)
+	}
+	if params.IofOverall != 0.0038 || params.IofPercentage != 0.000082 || params.RequestedDate.IsZero() {
+		t.Errorf("Expected the IOF of DefaultProduct requested now, got %+v", params)
+	}
+}
+
+func TestCalculatorRoundingAndHooks(t *testing.T) {
+	type call struct {
+		amount float64
+		plans  int
+		info   payment_plan.CalculationInfo
+	}
+	var calls []call
+	c := payment_plan.NewCalculator(
+		payment_plan.WithCache(payment_plan.CacheOptions{}),
+		payment_plan.WithRounding(2),
+		payment_plan.WithHooks(payment_plan.Hooks{
+			PaymentPlan: func(params payment_plan.Params, response []payment_plan.Response, info payment_plan.CalculationInfo) {
+				info.Duration = 0
+				calls = append(calls, call{params.RequestedAmount, len(response), info})
+			},
+		}),
+	)
+	base := payment_plantest.NewParams()
+	for _, params := range []payment_plan.Params{base.Amount(7800).Build(), base.Amount(7799.999999999999).Build(), base.Installments(0).Build()} {
+		c.CalculatePaymentPlan(params)
+	}
+
+	if len(calls) != 3 {
+		t.Fatalf("Expected 3 calls of the hook, got %+v", calls)
+	}
+	if calls[0] != (call{7800, 4, payment_plan.CalculationInfo{}}) || calls[1] != (call{7800, 4, payment_plan.CalculationInfo{Cached: true}}) {
+		t.Errorf("Expected a miss and a hit of 7800 in 4 plans, got %+v", calls[:2])
+	}
+	if calls[2].plans != 0 || calls[2].info.Err == nil {
+		t.Errorf("Expected the error of 0 installments, got %+v", calls[2])
+	}
+}
+
+func TestCalculatorRoundingDefaultProduct(t *testing.T) {
+	var calculated payment_plan.Params
+	c := payment_plan.NewCalculator(
+		payment_plan.WithClock(func() time.Time { return payment_plantest.Date(2025, 04, 5) }),
+		payment_plan.WithRounding(2),
+		payment_plan.WithHooks(payment_plan.Hooks{
+			PaymentPlan: func(params payment_plan.Params, response []payment_plan.Response, info payment_plan.CalculationInfo) {
+				calculated = params
+			},
+		}),
+	)
+	// The math.MaxFloat64 of no limit of DefaultProduct overflows to +Inf when scaled to cents.
+	response, err := c.PaymentPlan(7799.999999999999, 4, payment_plantest.Date(2025, 05, 3))
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	if len(response) != 4 {
+		t.Errorf("Expected 4 plans, got %d", len(response))
+	}
+	if calculated.RequestedAmount != 7800 || calculated.MaxTotalAmount != math.MaxFloat64 {
+		t.Errorf("Expected RequestedAmount 7800 and MaxTotalAmount %v, got %v and %v", math.MaxFloat64, calculated.RequestedAmount, calculated.MaxTotalAmount)
+	}
+}
+
+func TestCalculatorCalendar(t *testing.T) {
+	// A calendar without non business days, so the requested 2025-04-05 and 2025-04-07 are keyed as themselves,
+	// whatever the next disbursement date is.
+	base := payment_plantest.Date(2025, 04, 5)
+	c := payment_plan.NewCalculator(
+		payment_plan.WithCache(payment_plan.CacheOptions{}),
+		payment_plan.WithCalendar(payment_plan.Calendar{
+			NextDisbursementDate:   func(baseDate time.Time) time.Time { return baseDate.AddDate(0, 0, 1) },
+			NonBusinessDaysBetween: func(time.Time, time.Time) []time.Time { return nil },
+		}),
+	)
+
+	if next := c.NextDisbursementDate(base); !next.Equal(base.AddDate(0, 0, 1)) {
+		t.Errorf("Expected the next disbursement date of the calendar, got %v", next)
+	}
+	if days := c.GetNonBusinessDaysBetween(base, base.AddDate(0, 0, 30)); len(days) != 0 {
+		t.Errorf("Expected no non business days, got %v", days)
+	}
+	// DisbursementDateRange is not set, so it is the one of the package.
+	start, end := c.DisbursementDateRange(base, 5)
+	expectedStart, expectedEnd := payment_plan.DisbursementDateRange(base, 5)
+	if !start.Equal(expectedStart) || !end.Equal(expectedEnd) {
+		t.Errorf("Expected (%v, %v), got (%v, %v)", expectedStart, expectedEnd, start, end)
+	}
+
+	params := payment_plantest.NewParams()
+	for _, requested := range []time.Time{base, base.AddDate(0, 0, 2)} {
+		if _, err := c.CalculatePaymentPlan(params.Dates(requested, payment_plantest.Date(2025, 05, 3)).Build()); err != nil {
+			t.Fatalf("Error calculating payment plan: %v", err)
+		}
+	}
+	checkStats(t, c, payment_plan.CacheStats{Misses: 2, Entries: 2})
+
+	// A calendar whose next disbursement date is always the same, like the one of the package on a given day, doesn't share
+	// the plans of other requested dates: the keys don't change with the day the plans are calculated.
+	c = payment_plan.NewCalculator(
+		payment_plan.WithCache(payment_plan.CacheOptions{}),
+		payment_plan.WithCalendar(payment_plan.Calendar{
+			NextDisbursementDate: func(time.Time) time.Time { return payment_plantest.Date(2025, 04, 8) },
+		}),
+	)
+	for _, requested := range []time.Time{base, base.AddDate(0, 0, 2), base.AddDate(0, 0, 3)} {
+		if _, err := c.CalculatePaymentPlan(params.Dates(requested, payment_plantest.Date(2025, 05, 3)).Build()); err != nil {
+			t.Fatalf("Error calculating payment plan: %v", err)
+		}
+	}
+	// Saturday 2025-04-05 and Monday 2025-04-07 share their plans.
+	checkStats(t, c, payment_plan.CacheStats{Hits: 1, Misses: 2, Entries: 2})
+}
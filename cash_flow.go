@@ -0,0 +1,237 @@
+package payment_plan
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// businessDaysInMonth is the number of business days of a month in the discount factors of the native library, 252 a year.
+const businessDaysInMonth = 21
+
+// maxIofDays is the maximum number of days of daily IOF charged on the principal amortized by an installment.
+const maxIofDays = 365
+
+// scheduledDate is a due date of a cash flow, with its calendar days and its discount factor from the disbursement.
+type scheduledDate struct {
+	date           time.Time
+	days           int64
+	discountFactor float64
+}
+
+// businessDaySchedule returns the due dates with the discount factors of the native library, (1 + rate)^(-b/21),
+// b being the business days from the disbursement date up to the due date. The due dates must be sorted.
+func businessDaySchedule(rate float64, disbursementDate time.Time, dueDates []time.Time) []scheduledDate {
+	if len(dueDates) == 0 {
+		return nil
+	}
+	nonBusinessDays := dateSet(GetNonBusinessDaysBetween(disbursementDate, dueDates[len(dueDates)-1]))
+
+	schedule := make([]scheduledDate, len(dueDates))
+	for i, dueDate := range dueDates {
+		businessDays := businessDaysBetween(disbursementDate, dueDate, nonBusinessDays)
+		schedule[i] = scheduledDate{
+			date:           dueDate,
+			days:           daysBetween(disbursementDate, dueDate),
+			discountFactor: math.Pow(1+rate, -float64(businessDays)/businessDaysInMonth),
+		}
+	}
+	return schedule
+}
+
+// nativeSchedule returns the schedule of the longest plan of the response, with the discount factors of the native library,
+// since the response of n installments holds the days and the discount factor of the n-th due date.
+func nativeSchedule(response []Response) ([]scheduledDate, error) {
+	if _, err := scheduleOf(response); err != nil {
+		return nil, err
+	}
+	schedule := make([]scheduledDate, len(response))
+	for i, r := range response {
+		schedule[i] = scheduledDate{date: r.DueDate, days: r.AccumulatedDays, discountFactor: r.DaysIndex}
+	}
+	return schedule, nil
+}
+
+// cashFlow is a plan the native library doesn't calculate, e.g. one with other due dates, a grace period or a balloon,
+// described by the installments it pays at the dates of its schedule.
+type cashFlow struct {
+	schedule []scheduledDate
+	// installments returns the unrounded installments of a contract amount, one for each date of the schedule.
+	installments func(contractAmount float64) []float64
+	// first is the index of the first installment of the Response in the schedule. The ones before it, e.g. the interest
+	// only installments of a grace period, are part of the totals and the effective rates, but not of the installment count.
+	first int
+}
+
+// equalInstallments is the cash flow of the plans of the native library: equal installments at the dates of the schedule,
+// each one the contract amount over the sum of the discount factors.
+func equalInstallments(schedule []scheduledDate) cashFlow {
+	return cashFlow{
+		schedule: schedule,
+		installments: func(contractAmount float64) []float64 {
+			installment := contractAmount / accumulatedDiscountFactor(schedule)
+			installments := make([]float64, len(schedule))
+			for k := range installments {
+				installments[k] = installment
+			}
+			return installments
+		},
+	}
+}
+
+func accumulatedDiscountFactor(schedule []scheduledDate) float64 {
+	total := 0.0
+	for _, s := range schedule {
+		total += s.discountFactor
+	}
+	return total
+}
+
+// recalculate returns r, a response of the native library for params, with the fields that depend on the installments
+// recalculated for the cash flow the way the native library calculates its plans:
+//
+//   - the contract amount is the requested amount plus TAC plus IOF, where IOF is IofOverall of the contract amount plus
+//     IofPercentage a day, up to 365 days, of the principal amortized by each installment
+//   - the installments are rounded to cents, and the paid amounts are the rounded installments discounted by the schedule
+//   - the debit service is split between the customer and the merchant by params.DebitServicePercentage,
+//     and the merchant share is taken from the installments of the Response
+//   - the effective rates are the internal rates of return of the installments, over the contract amount (EIR)
+//     and over the requested amount (TEC), counting calendar days
+//
+// It also returns the rounded installments of the cash flow.
+func (f cashFlow) recalculate(params Params, r Response) (Response, []float64) {
+	financed := params.RequestedAmount + r.TacAmount
+	iterations := f.solveIof(params, financed)
+	settled := iterations[len(iterations)-1]
+	contractAmount, iof := settled.nextContractAmount, settled.iof
+
+	unrounded := f.installments(contractAmount)
+	installments := make([]float64, len(unrounded))
+	days := make([]int64, len(unrounded))
+	total, paid := 0.0, 0.0
+	for k, installment := range unrounded {
+		installments[k] = roundCents(installment)
+		days[k] = f.schedule[k].days
+		total += installments[k]
+		paid += installments[k] * f.schedule[k].discountFactor
+	}
+
+	regular := f.schedule[f.first:]
+	n := float64(len(regular))
+	last := regular[len(regular)-1]
+	r.DueDate = last.date
+	r.AccumulatedDays = last.days
+	r.DaysIndex = last.discountFactor
+	r.AccumulatedDaysIndex = accumulatedDiscountFactor(regular)
+
+	r.InstallmentAmount = installments[f.first]
+	r.TotalAmount = roundCents(total)
+	r.TotalIof = iof
+	r.ContractAmount = contractAmount
+	if r.TacAmount > 0 {
+		r.ContractAmountWithoutTac = roundCents(contractAmount - r.TacAmount)
+		r.InstallmentAmountWithoutTac = roundCents(unrounded[f.first] * r.ContractAmountWithoutTac / contractAmount)
+	}
+
+	r.DebitService = r.TotalAmount - contractAmount
+	r.MerchantDebitServiceAmount = r.DebitService * float64(params.DebitServicePercentage) / 100
+	r.CustomerDebitServiceAmount = r.DebitService - r.MerchantDebitServiceAmount
+	r.CustomerAmount = roundCents(r.InstallmentAmount - r.MerchantDebitServiceAmount/n)
+	r.CalculationBasisForEffectiveInterestRate = (params.RequestedAmount + r.CustomerDebitServiceAmount) / n
+	r.MerchantTotalAmount = r.MdrAmount + r.MerchantDebitServiceAmount
+	r.SettledToMerchant = params.RequestedAmount - r.MerchantTotalAmount
+
+	r.PaidContractAmount = roundCents(paid)
+	r.PaidTotalIof = roundCents(r.PaidContractAmount - financed)
+	r.PreDisbursementAmount = roundCents(r.PaidContractAmount - iof)
+
+	customer := slices.Clone(installments)
+	for k := f.first; k < len(customer); k++ {
+		customer[k] -= r.InstallmentAmount - r.CustomerAmount
+	}
+	eirYearly := yearlyRate(contractAmount, installments, days)
+	tecYearly := yearlyRate(params.RequestedAmount, customer, days)
+	r.EirYearly = roundTo(eirYearly, 6)
+	r.TecYearly = roundTo(tecYearly, 6)
+	r.EirMonthly = roundTo(math.Pow(1+eirYearly, 1.0/12)-1, 4)
+	r.TecMonthly = roundTo(math.Pow(1+tecYearly, 1.0/12)-1, 4)
+	r.EffectiveInterestRate = r.EirMonthly
+	r.TotalEffectiveCost = r.TecMonthly
+
+	return r, installments
+}
+
+// iofIteration is an iteration of the contract amount, whose IOF depends on the contract amount itself.
+type iofIteration struct {
+	contractAmount     float64
+	overallIof         float64
+	dailyIof           float64
+	iof                float64
+	nextContractAmount float64
+}
+
+// solveIof returns the iterations of the contract amount of the financed amount, starting from it without IOF,
+// until the contract amount settles on a cent or 100 iterations. The contract amount is the nextContractAmount of the last one.
+func (f cashFlow) solveIof(params Params, financed float64) []iofIteration {
+	var iterations []iofIteration
+	contractAmount := financed
+	for range 100 {
+		overall := roundCents(contractAmount * params.IofOverall)
+		daily := roundCents(f.dailyIof(params.IofPercentage, contractAmount))
+		iof := roundCents(overall + daily)
+		next := roundCents(financed + iof)
+		iterations = append(iterations, iofIteration{
+			contractAmount:     contractAmount,
+			overallIof:         overall,
+			dailyIof:           daily,
+			iof:                iof,
+			nextContractAmount: next,
+		})
+		if next == contractAmount {
+			break
+		}
+		contractAmount = next
+	}
+	return iterations
+}
+
+// dailyIof is the daily IOF of the principal amortized by each installment of the cash flow of the contract amount.
+func (f cashFlow) dailyIof(iofPercentage float64, contractAmount float64) float64 {
+	balance := contractAmount
+	previousFactor := 1.0
+	iof := 0.0
+	for k, installment := range f.installments(contractAmount) {
+		s := f.schedule[k]
+		amortization := installment - balance*(previousFactor/s.discountFactor-1)
+		iof += amortization * iofPercentage * float64(min(s.days, maxIofDays))
+		balance -= amortization
+		previousFactor = s.discountFactor
+	}
+	return iof
+}
+
+// yearlyRate returns the yearly internal rate of return of the installments, paid the given calendar days after the disbursement,
+// over the present value. It is found by bisection, which can't diverge.
+func yearlyRate(presentValue float64, installments []float64, days []int64) float64 {
+	value := func(rate float64) float64 {
+		total := 0.0
+		for k, installment := range installments {
+			total += installment * math.Pow(1+rate, -float64(days[k])/365)
+		}
+		return total
+	}
+
+	low, high := -0.99, 1.0
+	for value(high) > presentValue && high < 1e9 {
+		high *= 2
+	}
+	for range 200 {
+		mid := (low + high) / 2
+		if value(mid) > presentValue {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2
+}
@@ -0,0 +1,51 @@
+// Command payment-plan-schema writes the JSON Schema or the OpenAPI 3.1 components of the payment plan request and response types.
+//
+// It is meant to be used with go:generate, for example:
+//
+//	//go:generate go run github.com/ParceladoLara/payment-plan-go-sdk/cmd/payment-plan-schema -format openapi -o payment-plan.openapi.json
+//
+// Usage:
+//
+//	payment-plan-schema [-format jsonschema|openapi] [-o file]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+)
+
+func main() {
+	format := flag.String("format", "jsonschema", "output format: jsonschema or openapi")
+	output := flag.String("o", "", "output file, stdout if empty")
+	flag.Parse()
+
+	if err := run(*format, *output); err != nil {
+		fmt.Fprintln(os.Stderr, "payment-plan-schema:", err)
+		os.Exit(1)
+	}
+}
+
+func run(format string, output string) error {
+	var data []byte
+	var err error
+	switch format {
+	case "jsonschema":
+		data, err = payment_plan.JSONSchema()
+	case "openapi":
+		data, err = payment_plan.OpenAPIComponents()
+	default:
+		return fmt.Errorf("unknown format %q, expected jsonschema or openapi", format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(output, data, 0o644)
+}
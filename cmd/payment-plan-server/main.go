@@ -0,0 +1,92 @@
+// Command payment-plan-server serves the payment plan calculator as a REST API, for services that can't link the library.
+//
+// Requests and responses use the JSON encoding of the package, and responses have its version in the
+// Payment-Plan-Schema-Version header, see payment_plan.JSONSchemaVersion:
+//
+//	POST /v1/payment-plans                                 Params, returns []Response
+//	POST /v1/down-payment-plans                            DownPaymentParams, returns []DownPaymentResponse
+//	GET  /v1/business-days/next?date=YYYY-MM-DD            returns {"date": ...}
+//	GET  /v1/business-days/range?date=YYYY-MM-DD&days=N    returns {"start": ..., "end": ...}
+//	GET  /v1/business-days/non-business-days?start=&end=   returns {"dates": [...]}
+//	GET  /healthz                                          liveness
+//	GET  /readyz                                           readiness, fails while shutting down
+//
+// Errors are returned as {"error": {"code": ..., "message": ...}}, with status 400 for malformed requests, e.g. with
+// unknown fields or a range of more than a year, and 422 for params out of range or that can't be calculated.
+//
+// On SIGTERM /readyz fails first, and the server keeps serving for -drain so load balancers stop sending requests,
+// then it stops accepting connections and waits up to -shutdown-timeout for the in-flight ones.
+//
+// Usage:
+//
+//	payment-plan-server [-addr :8080] [-drain 5s] [-shutdown-timeout 15s]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	drain := flag.Duration("drain", 5*time.Second, "time to keep serving after failing the readiness check when shutting down")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 15*time.Second, "time to wait for in-flight requests when shutting down")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, *addr, *drain, *shutdownTimeout); err != nil {
+		fmt.Fprintln(os.Stderr, "payment-plan-server:", err)
+		os.Exit(1)
+	}
+}
+
+// run serves until ctx is done, then fails the readiness check, keeps serving for drain, and stops accepting connections,
+// waiting up to shutdownTimeout for in-flight requests.
+func run(ctx context.Context, addr string, drain time.Duration, shutdownTimeout time.Duration) error {
+	s := newServer(nativeCalculator)
+	httpServer := &http.Server{
+		Addr:              addr,
+		Handler:           s.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		log.Printf("listening on %s", addr)
+		errs <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Printf("shutting down, draining for %s", drain)
+	s.ready.Store(false)
+	select {
+	case err := <-errs:
+		return err
+	case <-time.After(drain):
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errs; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
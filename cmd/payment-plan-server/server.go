@@ -0,0 +1,231 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/internal/jsonfields"
+)
+
+// maxBodySize is the largest request body accepted, requests are a few hundred bytes.
+const maxBodySize = 1 << 20
+
+// maxRangeDays is the largest number of business days of /v1/business-days/range, more than a year of them.
+const maxRangeDays = 366
+
+// maxNonBusinessDaysSpan is the longest span of /v1/business-days/non-business-days, a leap year.
+const maxNonBusinessDaysSpan = 366 * 24 * time.Hour
+
+// dateLocation is the location of the dates in query parameters, the same one used by the JSON encoding of the requests.
+var dateLocation = time.FixedZone("-03", -3*60*60)
+
+// calculator holds the functions of the package that back the endpoints, so tests can replace the native library.
+type calculator struct {
+	paymentPlan            func(payment_plan.Params) ([]payment_plan.Response, error)
+	downPaymentPlan        func(payment_plan.DownPaymentParams) ([]payment_plan.DownPaymentResponse, error)
+	nextDisbursementDate   func(time.Time) time.Time
+	disbursementDateRange  func(time.Time, uint32) (time.Time, time.Time)
+	nonBusinessDaysBetween func(time.Time, time.Time) []time.Time
+}
+
+var nativeCalculator = calculator{
+	paymentPlan:            payment_plan.CalculatePaymentPlan,
+	downPaymentPlan:        payment_plan.CalculateDownPaymentPlan,
+	nextDisbursementDate:   payment_plan.NextDisbursementDate,
+	disbursementDateRange:  payment_plan.DisbursementDateRange,
+	nonBusinessDaysBetween: payment_plan.GetNonBusinessDaysBetween,
+}
+
+// server serves the REST API. Its readiness is cleared when shutting down, so load balancers stop sending requests.
+type server struct {
+	calculator calculator
+	ready      atomic.Bool
+}
+
+func newServer(c calculator) *server {
+	s := &server{calculator: c}
+	s.ready.Store(true)
+	return s
+}
+
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/payment-plans", s.paymentPlans)
+	mux.HandleFunc("POST /v1/down-payment-plans", s.downPaymentPlans)
+	mux.HandleFunc("GET /v1/business-days/next", s.nextBusinessDay)
+	mux.HandleFunc("GET /v1/business-days/range", s.businessDayRange)
+	mux.HandleFunc("GET /v1/business-days/non-business-days", s.nonBusinessDays)
+	mux.HandleFunc("GET /healthz", s.health)
+	mux.HandleFunc("GET /readyz", s.readiness)
+	return mux
+}
+
+// apiError is the body of error responses, e.g. {"error":{"code":"invalid_params","message":"installments 0 is out of range [1, +inf): invalid params"}}.
+type apiError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(payment_plan.JSONSchemaVersionHeader, strconv.Itoa(payment_plan.JSONSchemaVersion))
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, code string, err error) {
+	writeJSON(w, status, map[string]apiError{"error": {Code: code, Message: err.Error()}})
+}
+
+// writeCalculationError maps the errors of the package to responses: invalid params are the client's fault, anything else is not.
+func writeCalculationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, payment_plan.ErrInvalidParams):
+		writeError(w, http.StatusUnprocessableEntity, "invalid_params", err)
+	case errors.Is(err, payment_plan.ErrCalculationError):
+		writeError(w, http.StatusUnprocessableEntity, "calculation_error", err)
+	default:
+		writeError(w, http.StatusInternalServerError, "internal_error", err)
+	}
+}
+
+// decodeBody decodes the body into v, rejecting fields v doesn't have, so a misspelled field is not calculated as zero.
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	var body json.RawMessage
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&body)
+	if err == nil {
+		err = json.Unmarshal(body, v)
+	}
+	if err == nil {
+		err = jsonfields.Unknown(body, v)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_json", err)
+		return false
+	}
+	return true
+}
+
+func (s *server) paymentPlans(w http.ResponseWriter, r *http.Request) {
+	var params payment_plan.Params
+	if !decodeBody(w, r, &params) {
+		return
+	}
+	if err := payment_plan.ValidateParams(params); err != nil {
+		writeCalculationError(w, err)
+		return
+	}
+	response, err := s.calculator.paymentPlan(params)
+	if err != nil {
+		writeCalculationError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *server) downPaymentPlans(w http.ResponseWriter, r *http.Request) {
+	var params payment_plan.DownPaymentParams
+	if !decodeBody(w, r, &params) {
+		return
+	}
+	if err := payment_plan.ValidateDownPaymentParams(params); err != nil {
+		writeCalculationError(w, err)
+		return
+	}
+	response, err := s.calculator.downPaymentPlan(params)
+	if err != nil {
+		writeCalculationError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// queryDate reads a YYYY-MM-DD query parameter, writing the error response if it is missing or invalid.
+func queryDate(w http.ResponseWriter, r *http.Request, name string) (time.Time, bool) {
+	value := r.URL.Query().Get(name)
+	t, err := time.ParseInLocation(time.DateOnly, value, dateLocation)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_query", fmt.Errorf("%s %q is not a date, expected YYYY-MM-DD", name, value))
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func formatDates(dates []time.Time) []string {
+	formatted := make([]string, len(dates))
+	for i, date := range dates {
+		formatted[i] = date.Format(time.DateOnly)
+	}
+	return formatted
+}
+
+// nextBusinessDay serves GET /v1/business-days/next?date=2025-04-03.
+func (s *server) nextBusinessDay(w http.ResponseWriter, r *http.Request) {
+	date, ok := queryDate(w, r, "date")
+	if !ok {
+		return
+	}
+	next := s.calculator.nextDisbursementDate(date)
+	writeJSON(w, http.StatusOK, map[string]string{"date": next.Format(time.DateOnly)})
+}
+
+// businessDayRange serves GET /v1/business-days/range?date=2025-04-03&days=5.
+func (s *server) businessDayRange(w http.ResponseWriter, r *http.Request) {
+	date, ok := queryDate(w, r, "date")
+	if !ok {
+		return
+	}
+	days, err := strconv.ParseUint(r.URL.Query().Get("days"), 10, 32)
+	if err != nil || days == 0 {
+		writeError(w, http.StatusBadRequest, "invalid_query", fmt.Errorf("days %q is not a positive integer", r.URL.Query().Get("days")))
+		return
+	}
+	if days > maxRangeDays {
+		writeError(w, http.StatusBadRequest, "invalid_query", fmt.Errorf("days %d is more than %d", days, maxRangeDays))
+		return
+	}
+	start, end := s.calculator.disbursementDateRange(date, uint32(days))
+	writeJSON(w, http.StatusOK, map[string]string{"start": start.Format(time.DateOnly), "end": end.Format(time.DateOnly)})
+}
+
+// nonBusinessDays serves GET /v1/business-days/non-business-days?start=2025-04-01&end=2025-04-30, both inclusive.
+func (s *server) nonBusinessDays(w http.ResponseWriter, r *http.Request) {
+	start, ok := queryDate(w, r, "start")
+	if !ok {
+		return
+	}
+	end, ok := queryDate(w, r, "end")
+	if !ok {
+		return
+	}
+	if end.Before(start) {
+		writeError(w, http.StatusBadRequest, "invalid_query", errors.New("end is before start"))
+		return
+	}
+	if end.Sub(start) > maxNonBusinessDaysSpan {
+		writeError(w, http.StatusBadRequest, "invalid_query", fmt.Errorf("start and end are more than %d days apart", maxNonBusinessDaysSpan/(24*time.Hour)))
+		return
+	}
+	dates := s.calculator.nonBusinessDaysBetween(start, end)
+	writeJSON(w, http.StatusOK, map[string][]string{"dates": formatDates(dates)})
+}
+
+// health serves the liveness check, the process is alive while it answers.
+func (s *server) health(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// readiness serves the readiness check, which fails once the server is shutting down.
+func (s *server) readiness(w http.ResponseWriter, r *http.Request) {
+	if !s.ready.Load() {
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "shutting down"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
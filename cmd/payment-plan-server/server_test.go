@@ -0,0 +1,122 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+)
+
+var fakeCalculator = calculator{
+	paymentPlan: func(params payment_plan.Params) ([]payment_plan.Response, error) {
+		if params.MaxTotalAmount < params.RequestedAmount {
+			return nil, payment_plan.ErrCalculationError
+		}
+		return []payment_plan.Response{{Installment: 1, DueDate: params.FirstPaymentDate, InstallmentAmount: 7996.8}}, nil
+	},
+	downPaymentPlan: func(params payment_plan.DownPaymentParams) ([]payment_plan.DownPaymentResponse, error) {
+		return []payment_plan.DownPaymentResponse{{InstallmentQuantity: params.Installments}}, nil
+	},
+	nextDisbursementDate: func(date time.Time) time.Time { return date.AddDate(0, 0, 1) },
+	disbursementDateRange: func(date time.Time, days uint32) (time.Time, time.Time) {
+		return date, date.AddDate(0, 0, int(days))
+	},
+	nonBusinessDaysBetween: func(start time.Time, end time.Time) []time.Time { return []time.Time{start, end} },
+}
+
+const paramsBody = `{
+	"requested_amount": 7800,
+	"first_payment_date": "2025-05-03",
+	"requested_date": "2025-04-05",
+	"installments": 1,
+	"debit_service_percentage": 0,
+	"mdr": 0.05,
+	"tac_percentage": 0,
+	"iof_overall": 0.0038,
+	"iof_percentage": 0.000082,
+	"interest_rate": 0.0235,
+	"min_installment_amount": 100,
+	"max_total_amount": 1000000,
+	"disbursement_only_on_business_days": false
+}`
+
+func TestServer(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		body     string
+		status   int
+		contains string
+	}{
+		{"payment plans", http.MethodPost, "/v1/payment-plans", paramsBody, http.StatusOK, `"due_date":"2025-05-03"`},
+		{"malformed json", http.MethodPost, "/v1/payment-plans", `{"requested_amount":`, http.StatusBadRequest, `"code":"invalid_json"`},
+		{"unknown field", http.MethodPost, "/v1/payment-plans", strings.Replace(paramsBody, `"interest_rate"`, `"intrest_rate"`, 1),
+			http.StatusBadRequest, `"message":"json: unknown field \"intrest_rate\""`},
+		{"unknown nested field", http.MethodPost, "/v1/down-payment-plans",
+			`{"params":` + strings.Replace(paramsBody, `"mdr"`, `"mdr_percentage"`, 1) + `,"requested_amount":1000,"min_installment_amount":100,"first_payment_date":"2025-04-05","installments":3}`,
+			http.StatusBadRequest, `"message":"json: unknown field \"params.mdr_percentage\""`},
+		{"invalid params", http.MethodPost, "/v1/payment-plans", strings.Replace(paramsBody, `"installments": 1`, `"installments": 0`, 1),
+			http.StatusUnprocessableEntity, `"message":"installments 0 is out of range [1, +inf)`},
+		{"calculation error", http.MethodPost, "/v1/payment-plans", strings.Replace(paramsBody, `1000000`, `10`, 1),
+			http.StatusUnprocessableEntity, `"code":"calculation_error"`},
+		{"down payment plans", http.MethodPost, "/v1/down-payment-plans",
+			`{"params":` + paramsBody + `,"requested_amount":1000,"min_installment_amount":100,"first_payment_date":"2025-04-05","installments":3}`,
+			http.StatusOK, `"installment_quantity":3`},
+		{"wrong method", http.MethodGet, "/v1/payment-plans", "", http.StatusMethodNotAllowed, ""},
+		{"next", http.MethodGet, "/v1/business-days/next?date=2025-04-03", "", http.StatusOK, `{"date":"2025-04-04"}`},
+		{"next without date", http.MethodGet, "/v1/business-days/next", "", http.StatusBadRequest, `"code":"invalid_query"`},
+		{"range", http.MethodGet, "/v1/business-days/range?date=2025-04-03&days=5", "", http.StatusOK, `{"end":"2025-04-08","start":"2025-04-03"}`},
+		{"range without days", http.MethodGet, "/v1/business-days/range?date=2025-04-03", "", http.StatusBadRequest, `"code":"invalid_query"`},
+		{"range of a year", http.MethodGet, "/v1/business-days/range?date=2025-04-03&days=366", "", http.StatusOK, `"end":"2026-04-04"`},
+		{"range over a year", http.MethodGet, "/v1/business-days/range?date=2025-04-03&days=367", "", http.StatusBadRequest,
+			`"message":"days 367 is more than 366"`},
+		{"non business days", http.MethodGet, "/v1/business-days/non-business-days?start=2025-04-05&end=2025-04-06", "", http.StatusOK,
+			`{"dates":["2025-04-05","2025-04-06"]}`},
+		{"non business days of a leap year", http.MethodGet, "/v1/business-days/non-business-days?start=2024-01-01&end=2025-01-01", "", http.StatusOK,
+			`{"dates":["2024-01-01","2025-01-01"]}`},
+		{"non business days over a year", http.MethodGet, "/v1/business-days/non-business-days?start=2024-01-01&end=2025-01-02", "",
+			http.StatusBadRequest, `"message":"start and end are more than 366 days apart"`},
+		{"health", http.MethodGet, "/healthz", "", http.StatusOK, `"status":"ok"`},
+	}
+
+	handler := newServer(fakeCalculator).handler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			if recorder.Code != tt.status {
+				t.Errorf("Expected status %d, got %d: %s", tt.status, recorder.Code, recorder.Body.String())
+			}
+			if !strings.Contains(recorder.Body.String(), tt.contains) {
+				t.Errorf("Expected %s in body, got %s", tt.contains, recorder.Body.String())
+			}
+			if version := recorder.Header().Get("Payment-Plan-Schema-Version"); tt.status != http.StatusMethodNotAllowed && version != "1" {
+				t.Errorf("Expected schema version 1, got %q", version)
+			}
+		})
+	}
+}
+
+func TestServerReadiness(t *testing.T) {
+	s := newServer(fakeCalculator)
+	handler := s.handler()
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if recorder.Code != http.StatusOK {
+		t.Errorf("Expected ready, got %d", recorder.Code)
+	}
+
+	s.ready.Store(false)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected not ready when shutting down, got %d", recorder.Code)
+	}
+}
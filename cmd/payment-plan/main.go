@@ -0,0 +1,218 @@
+// Command payment-plan simulates payment plans from the command line.
+//
+// Usage:
+//
+//	payment-plan plan [-params file] [param flags] [-format table|json|csv] [-columns list] [-locale en-US|pt-BR]
+//	payment-plan down-payment [-params file] [param flags] [down payment flags] [-option n] [-format ...]
+//	payment-plan next-disbursement [-date YYYY-MM-DD] [-format ...]
+//	payment-plan range [-date YYYY-MM-DD] -days n [-format ...]
+//	payment-plan holidays -start YYYY-MM-DD -end YYYY-MM-DD [-format ...]
+//
+// The params of plan and down-payment are read from a JSON file, in the encoding of the package, and the param flags
+// override its fields. Without a file, the requested date is today and the first payment date is a month later.
+// The JSON output is {"schema_version": ..., "data": ...}, data in the encoding of payment_plan.JSONSchemaVersion.
+// Run a subcommand with -h for its flags.
+//
+// For example:
+//
+//	payment-plan plan -amount 7800 -installments 12 -mdr 0.05 -iof-overall 0.0038 -iof-percentage 0.000082 -interest-rate 0.0235
+//	payment-plan plan -params product.json -amount 10000 -format csv -locale pt-BR > plan.csv
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+)
+
+const usage = `usage: payment-plan <command> [flags]
+
+commands:
+  plan               calculate a payment plan
+  down-payment       calculate a down payment plan
+  next-disbursement  print the next disbursement date
+  range              print the range of disbursement dates that fits a number of business days
+  holidays           print the non business days between two dates
+`
+
+func main() {
+	err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr, time.Now())
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "payment-plan:", err)
+		os.Exit(1)
+	}
+}
+
+// run runs the command of args. Results are written to stdout, and the usage and the flag errors to stderr.
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer, now time.Time) error {
+	if len(args) == 0 {
+		return errors.New("missing command\n" + usage)
+	}
+
+	command, args := args[0], args[1:]
+	switch command {
+	case "plan":
+		return plan(newFlagSet("plan", stderr), args, stdin, stdout, now)
+	case "down-payment":
+		return downPayment(newFlagSet("down-payment", stderr), args, stdin, stdout, now)
+	case "next-disbursement":
+		return nextDisbursement(newFlagSet("next-disbursement", stderr), args, stdout, now)
+	case "range":
+		return disbursementRange(newFlagSet("range", stderr), args, stdout, now)
+	case "holidays":
+		return holidays(newFlagSet("holidays", stderr), args, stdout)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stderr, usage)
+		return flag.ErrHelp
+	default:
+		return fmt.Errorf("unknown command %q\n%s", command, usage)
+	}
+}
+
+// newFlagSet returns the flag set of a command, which writes its usage and errors to stderr.
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	return fs
+}
+
+func plan(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer, now time.Time) error {
+	paramsFlags := newParamsFlags(fs, func(p *payment_plan.Params) *payment_plan.Params { return p })
+	output := newOutputFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	params, err := paramsFlags.load(stdin, now)
+	if err != nil {
+		return err
+	}
+	if err := payment_plan.ValidateParams(params); err != nil {
+		return err
+	}
+	response, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		return err
+	}
+	return output.writeResponses(stdout, response)
+}
+
+func downPayment(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer, now time.Time) error {
+	paramsFlags := newParamsFlags(fs, func(p *payment_plan.DownPaymentParams) *payment_plan.Params { return &p.Params })
+	fs.Func("down-payment", "down payment amount", func(s string) error {
+		v, err := parseFloat(s)
+		paramsFlags.setOuter(func(p *payment_plan.DownPaymentParams) { p.RequestedAmount = v })
+		return err
+	})
+	fs.Func("down-payment-installments", "maximum number of down payment installments", func(s string) error {
+		v, err := parseUint32(s)
+		paramsFlags.setOuter(func(p *payment_plan.DownPaymentParams) { p.Installments = v })
+		return err
+	})
+	fs.Func("down-payment-min-installment", "minimum down payment installment amount", func(s string) error {
+		v, err := parseFloat(s)
+		paramsFlags.setOuter(func(p *payment_plan.DownPaymentParams) { p.MinInstallmentAmount = v })
+		return err
+	})
+	fs.Func("down-payment-first-payment", "first down payment date, the requested date by default, YYYY-MM-DD", func(s string) error {
+		v, err := parseDate(s)
+		paramsFlags.setOuter(func(p *payment_plan.DownPaymentParams) { p.FirstPaymentDate = v })
+		return err
+	})
+	option := fs.Uint("option", 0, "print the payment plans of the down payment option with this number of installments instead of the options")
+	output := newOutputFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	params, err := paramsFlags.load(stdin, now)
+	if err != nil {
+		return err
+	}
+	if params.FirstPaymentDate.IsZero() {
+		params.FirstPaymentDate = params.Params.RequestedDate
+	}
+	if err := payment_plan.ValidateDownPaymentParams(params); err != nil {
+		return err
+	}
+	response, err := payment_plan.CalculateDownPaymentPlan(params)
+	if err != nil {
+		return err
+	}
+
+	if *option == 0 {
+		return output.writeDownPayments(stdout, response)
+	}
+	for _, r := range response {
+		if uint(r.InstallmentQuantity) == *option {
+			return output.writeResponses(stdout, r.Plans)
+		}
+	}
+	return fmt.Errorf("there is no down payment option with %d installments", *option)
+}
+
+func nextDisbursement(fs *flag.FlagSet, args []string, stdout io.Writer, now time.Time) error {
+	date := dateFlag{now}
+	fs.Var(&date, "date", "base date, today by default, YYYY-MM-DD")
+	output := newOutputFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	next := payment_plan.NextDisbursementDate(date.Time)
+	return output.writeDates(stdout, []string{"date"}, [][]time.Time{{next}}, map[string]string{"date": next.Format(time.DateOnly)})
+}
+
+func disbursementRange(fs *flag.FlagSet, args []string, stdout io.Writer, now time.Time) error {
+	date := dateFlag{now}
+	fs.Var(&date, "date", "base date, today by default, YYYY-MM-DD")
+	var days uint32
+	fs.Func("days", "number of business days of the range", func(s string) (err error) {
+		days, err = parseUint32(s)
+		return err
+	})
+	output := newOutputFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if days == 0 {
+		return errors.New("-days must be a positive number")
+	}
+
+	start, end := payment_plan.DisbursementDateRange(date.Time, days)
+	return output.writeDates(stdout, []string{"start", "end"}, [][]time.Time{{start, end}},
+		map[string]string{"start": start.Format(time.DateOnly), "end": end.Format(time.DateOnly)})
+}
+
+func holidays(fs *flag.FlagSet, args []string, stdout io.Writer) error {
+	var start, end dateFlag
+	fs.Var(&start, "start", "first date, inclusive, YYYY-MM-DD")
+	fs.Var(&end, "end", "last date, inclusive, YYYY-MM-DD")
+	output := newOutputFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if start.IsZero() || end.IsZero() {
+		return errors.New("-start and -end are required")
+	}
+	if end.Before(start.Time) {
+		return errors.New("-end is before -start")
+	}
+
+	days := payment_plan.GetNonBusinessDaysBetween(start.Time, end.Time)
+	rows := make([][]time.Time, len(days))
+	formatted := make([]string, len(days))
+	for i, day := range days {
+		rows[i] = []time.Time{day}
+		formatted[i] = day.Format(time.DateOnly)
+	}
+	return output.writeDates(stdout, []string{"date"}, rows, map[string][]string{"dates": formatted})
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+)
+
+var today = time.Date(2025, 04, 5, 10, 30, 0, 0, time.UTC)
+
+func writeParams(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Error encoding params: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "params.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("Error writing params: %v", err)
+	}
+	return path
+}
+
+func TestParamsFlags(t *testing.T) {
+	path := writeParams(t, payment_plan.Params{
+		RequestedAmount:  7800,
+		FirstPaymentDate: time.Date(2025, 05, 3, 0, 0, 0, 0, dateLocation),
+		RequestedDate:    time.Date(2025, 04, 5, 0, 0, 0, 0, dateLocation),
+		Installments:     4,
+		Mdr:              0.05,
+		InterestRate:     0.0235,
+		MaxTotalAmount:   1000000,
+	})
+
+	fs := flag.NewFlagSet("plan", flag.ContinueOnError)
+	f := newParamsFlags(fs, func(p *payment_plan.Params) *payment_plan.Params { return p })
+	if err := fs.Parse([]string{"-params", path, "-amount", "10000", "-business-days"}); err != nil {
+		t.Fatalf("Error parsing flags: %v", err)
+	}
+	params, err := f.load(nil, today)
+	if err != nil {
+		t.Fatalf("Error loading params: %v", err)
+	}
+	if params.RequestedAmount != 10000 || params.Installments != 4 || params.Mdr != 0.05 || !params.DisbursementOnlyOnBusinessDays {
+		t.Errorf("Expected the file params with the flags applied, got %+v", params)
+	}
+
+	fs = flag.NewFlagSet("plan", flag.ContinueOnError)
+	f = newParamsFlags(fs, func(p *payment_plan.Params) *payment_plan.Params { return p })
+	if err := fs.Parse([]string{"-installments", "3"}); err != nil {
+		t.Fatalf("Error parsing flags: %v", err)
+	}
+	params, err = f.load(nil, today)
+	if err != nil {
+		t.Fatalf("Error loading params: %v", err)
+	}
+	if params.RequestedDate.Format(time.DateOnly) != "2025-04-05" || params.FirstPaymentDate.Format(time.DateOnly) != "2025-05-05" {
+		t.Errorf("Expected default dates, got %v and %v", params.RequestedDate, params.FirstPaymentDate)
+	}
+	if params.Installments != 3 || params.MaxTotalAmount == 0 {
+		t.Errorf("Expected defaults with the flags applied, got %+v", params)
+	}
+
+	// A misspelled field is an error instead of a zero interest rate.
+	misspelled := filepath.Join(t.TempDir(), "params.json")
+	if err := os.WriteFile(misspelled, []byte(`{"requested_amount":7800,"intrest_rate":0.0235}`), 0o644); err != nil {
+		t.Fatalf("Error writing params: %v", err)
+	}
+	fs = flag.NewFlagSet("plan", flag.ContinueOnError)
+	f = newParamsFlags(fs, func(p *payment_plan.Params) *payment_plan.Params { return p })
+	if err := fs.Parse([]string{"-params", misspelled}); err != nil {
+		t.Fatalf("Error parsing flags: %v", err)
+	}
+	if _, err := f.load(nil, today); err == nil || !strings.Contains(err.Error(), `unknown field "intrest_rate"`) {
+		t.Errorf("Expected an unknown field error, got %v", err)
+	}
+}
+
+func TestWriteDownPayments(t *testing.T) {
+	response := []payment_plan.DownPaymentResponse{{
+		InstallmentAmount:   1234.5,
+		TotalAmount:         3703.5,
+		InstallmentQuantity: 3,
+		FirstPaymentDate:    time.Date(2025, 04, 5, 7, 0, 0, 0, dateLocation),
+	}}
+
+	fs := flag.NewFlagSet("down-payment", flag.ContinueOnError)
+	output := newOutputFlags(fs)
+	if err := fs.Parse([]string{"-format", "csv", "-locale", "pt-BR"}); err != nil {
+		t.Fatalf("Error parsing flags: %v", err)
+	}
+	var stdout bytes.Buffer
+	if err := output.writeDownPayments(&stdout, response); err != nil {
+		t.Fatalf("Error writing down payments: %v", err)
+	}
+	if expected := "3;1.234,50;3.703,50;05/04/2025;0\n"; !strings.HasSuffix(stdout.String(), expected) {
+		t.Errorf("Expected %q, got %q", expected, stdout.String())
+	}
+}
+
+func TestRunHelp(t *testing.T) {
+	for _, args := range [][]string{{"help"}, {"plan", "-h"}} {
+		var stdout, stderr bytes.Buffer
+		if err := run(args, nil, &stdout, &stderr, today); !errors.Is(err, flag.ErrHelp) {
+			t.Errorf("%v: Expected flag.ErrHelp, got %v", args, err)
+		}
+		if stdout.Len() != 0 || !strings.Contains(strings.ToLower(stderr.String()), "usage") {
+			t.Errorf("%v: Expected the usage in stderr, got %q and %q", args, stdout.String(), stderr.String())
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	path := writeParams(t, payment_plan.Params{
+		RequestedAmount:                7800,
+		FirstPaymentDate:               time.Date(2025, 05, 3, 0, 0, 0, 0, dateLocation),
+		RequestedDate:                  time.Date(2025, 04, 5, 0, 0, 0, 0, dateLocation),
+		Installments:                   4,
+		Mdr:                            0.05,
+		IofOverall:                     0.0038,
+		IofPercentage:                  0.000082,
+		InterestRate:                   0.0235,
+		MinInstallmentAmount:           100,
+		MaxTotalAmount:                 1000000,
+		DisbursementOnlyOnBusinessDays: true,
+	})
+
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"plan", "-params", path, "-installments", "3", "-format", "csv", "-columns", "installment"}, "Installment\n1\n2\n3\n"},
+		{[]string{"plan", "-params", path, "-format", "table", "-columns", "installment,due_date", "-locale", "pt-BR"}, "Parcelas  Vencimento\n1         "},
+		{[]string{"range", "-date", "2025-04-03", "-days", "5", "-format", "json"}, `"start": "`},
+		{[]string{"next-disbursement", "-date", "2025-04-03", "-format", "json"}, "{\n  \"schema_version\": 1,\n  \"data\": "},
+	}
+
+	for _, tt := range tests {
+		var stdout bytes.Buffer
+		if err := run(tt.args, nil, &stdout, &bytes.Buffer{}, today); err != nil {
+			t.Errorf("%v: %v", tt.args, err)
+			continue
+		}
+		if !strings.Contains(stdout.String(), tt.expected) {
+			t.Errorf("%v: Expected %q in output, got %q", tt.args, tt.expected, stdout.String())
+		}
+	}
+
+	for _, args := range [][]string{
+		{"plan", "-params", path, "-installments", "0"},
+		{"plan", "-format", "xml", "-params", path},
+		{"plan", "-locale", "pt-PT", "-params", path},
+		{"holidays", "-start", "2025-04-05"},
+		{"unknown"},
+	} {
+		if err := run(args, nil, &bytes.Buffer{}, &bytes.Buffer{}, today); err == nil {
+			t.Errorf("%v: Expected an error", args)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+)
+
+// outputFlags are the flags that select how results are printed.
+type outputFlags struct {
+	format  string
+	columns string
+	locale  payment_plan.Locale
+}
+
+func newOutputFlags(fs *flag.FlagSet) *outputFlags {
+	o := &outputFlags{locale: payment_plan.LocaleEnUS}
+	fs.StringVar(&o.format, "format", "table", "output format: table, json or csv")
+	fs.StringVar(&o.columns, "columns", "", "comma separated response columns of table and csv, e.g. installment,installment_amount")
+	fs.Func("locale", "locale of table and csv headers, numbers and dates: en-US, the default, or pt-BR", func(s string) error {
+		if _, ok := payment_plan.DefaultTemplates[payment_plan.Locale(s)]; !ok {
+			return fmt.Errorf("unknown locale %q, expected en-US or pt-BR", s)
+		}
+		o.locale = payment_plan.Locale(s)
+		return nil
+	})
+	return o
+}
+
+func (o *outputFlags) csvOptions() payment_plan.CSVOptions {
+	options := payment_plan.CSVOptions{Locale: o.locale}
+	if o.columns != "" {
+		options.Columns = strings.Split(o.columns, ",")
+	}
+	return options
+}
+
+func (o *outputFlags) writeResponses(w io.Writer, response []payment_plan.Response) error {
+	switch o.format {
+	case "json":
+		return writeJSON(w, response)
+	case "csv":
+		return payment_plan.WriteCSV(w, response, o.csvOptions())
+	case "table":
+		// The table is the CSV with tabs, aligned.
+		var buf bytes.Buffer
+		options := o.csvOptions()
+		options.Comma = '\t'
+		if err := payment_plan.WriteCSV(&buf, response, options); err != nil {
+			return err
+		}
+		return alignTabs(w, buf.Bytes())
+	default:
+		return fmt.Errorf("unknown format %q, expected table, json or csv", o.format)
+	}
+}
+
+func (o *outputFlags) writeDownPayments(w io.Writer, response []payment_plan.DownPaymentResponse) error {
+	if o.format == "json" {
+		return writeJSON(w, response)
+	}
+
+	header := []string{"installment_quantity", "installment_amount", "total_amount", "first_payment_date", "plans"}
+	rows := make([][]string, len(response))
+	for i, r := range response {
+		rows[i] = []string{
+			strconv.FormatUint(uint64(r.InstallmentQuantity), 10),
+			payment_plan.FormatNumber(r.InstallmentAmount, 2, o.locale),
+			payment_plan.FormatNumber(r.TotalAmount, 2, o.locale),
+			o.date(r.FirstPaymentDate),
+			strconv.Itoa(len(r.Plans)),
+		}
+	}
+	return o.writeRows(w, header, rows)
+}
+
+// writeDates writes rows of dates, or value in JSON.
+func (o *outputFlags) writeDates(w io.Writer, header []string, dates [][]time.Time, value any) error {
+	if o.format == "json" {
+		return writeJSON(w, value)
+	}
+
+	rows := make([][]string, len(dates))
+	for i, row := range dates {
+		rows[i] = make([]string, len(row))
+		for j, date := range row {
+			rows[i][j] = o.date(date)
+		}
+	}
+	return o.writeRows(w, header, rows)
+}
+
+func (o *outputFlags) writeRows(w io.Writer, header []string, rows [][]string) error {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	switch o.format {
+	case "csv":
+		if o.locale == payment_plan.LocalePtBR {
+			writer.Comma = ';'
+		}
+	case "table":
+		writer.Comma = '\t'
+	default:
+		return fmt.Errorf("unknown format %q, expected table, json or csv", o.format)
+	}
+	writer.Write(header)
+	writer.WriteAll(rows)
+	if err := writer.Error(); err != nil {
+		return err
+	}
+
+	if o.format == "table" {
+		return alignTabs(w, buf.Bytes())
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func (o *outputFlags) date(t time.Time) string {
+	return payment_plan.FormatDate(t, o.locale)
+}
+
+// alignTabs writes tab separated lines as aligned columns.
+func alignTabs(w io.Writer, data []byte) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := tw.Write(data); err != nil {
+		return err
+	}
+	return tw.Flush()
+}
+
+// jsonOutput is the JSON output of the commands, the result with the version of its encoding.
+type jsonOutput struct {
+	SchemaVersion int `json:"schema_version"`
+	Data          any `json:"data"`
+}
+
+func writeJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(jsonOutput{SchemaVersion: payment_plan.JSONSchemaVersion, Data: v})
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/internal/jsonfields"
+)
+
+// dateLocation is the location of the dates in flags, the same one used by the JSON encoding of the params.
+var dateLocation = time.FixedZone("-03", -3*60*60)
+
+func parseFloat(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+func parseUint32(s string) (uint32, error) {
+	v, err := strconv.ParseUint(s, 10, 32)
+	return uint32(v), err
+}
+
+func parseDate(s string) (time.Time, error) {
+	t, err := time.ParseInLocation(time.DateOnly, s, dateLocation)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", s)
+	}
+	return t, nil
+}
+
+// dateFlag is a YYYY-MM-DD flag.
+type dateFlag struct {
+	time.Time
+}
+
+func (d *dateFlag) String() string {
+	if d == nil || d.IsZero() {
+		return ""
+	}
+	return d.Format(time.DateOnly)
+}
+
+func (d *dateFlag) Set(s string) error {
+	t, err := parseDate(s)
+	if err != nil {
+		return err
+	}
+	d.Time = t
+	return nil
+}
+
+// paramsFlags registers a flag for each field of a Params. The flags override the fields of the JSON file given by -params,
+// so an analyst can keep a product in a file and change only the amount or the number of installments.
+type paramsFlags[T any] struct {
+	file   string
+	params func(*T) *payment_plan.Params
+	// setters apply the flags that were set, in order, after the file is read.
+	setters []func(*T)
+}
+
+func newParamsFlags[T any](fs *flag.FlagSet, params func(*T) *payment_plan.Params) *paramsFlags[T] {
+	f := &paramsFlags[T]{params: params}
+	fs.StringVar(&f.file, "params", "", "JSON `file` with the params, - for stdin")
+
+	f.float(fs, "amount", "requested amount", func(p *payment_plan.Params, v float64) { p.RequestedAmount = v })
+	f.date(fs, "first-payment", "first payment date, a month after the requested date by default", func(p *payment_plan.Params, v time.Time) { p.FirstPaymentDate = v })
+	f.date(fs, "requested-date", "requested date, today by default", func(p *payment_plan.Params, v time.Time) { p.RequestedDate = v })
+	f.uint(fs, "installments", "maximum number of installments", func(p *payment_plan.Params, v uint32) { p.Installments = v })
+	fs.Func("debit-service", "percentage of the debit service paid by the merchant, 0 to 100", func(s string) error {
+		v, err := strconv.ParseUint(s, 10, 16)
+		f.set(func(p *payment_plan.Params) { p.DebitServicePercentage = uint16(v) })
+		return err
+	})
+	f.float(fs, "mdr", "MDR, e.g. 0.05", func(p *payment_plan.Params, v float64) { p.Mdr = v })
+	f.float(fs, "tac", "TAC percentage, e.g. 0.05", func(p *payment_plan.Params, v float64) { p.TacPercentage = v })
+	f.float(fs, "iof-overall", "overall IOF, e.g. 0.0038", func(p *payment_plan.Params, v float64) { p.IofOverall = v })
+	f.float(fs, "iof-percentage", "daily IOF, e.g. 0.000082", func(p *payment_plan.Params, v float64) { p.IofPercentage = v })
+	f.float(fs, "interest-rate", "monthly interest rate, e.g. 0.0235", func(p *payment_plan.Params, v float64) { p.InterestRate = v })
+	f.float(fs, "min-installment", "minimum installment amount", func(p *payment_plan.Params, v float64) { p.MinInstallmentAmount = v })
+	f.float(fs, "max-total", "maximum total amount, no limit by default", func(p *payment_plan.Params, v float64) { p.MaxTotalAmount = v })
+	fs.BoolFunc("business-days", "disburse only on business days", func(s string) error {
+		v, err := strconv.ParseBool(s)
+		f.set(func(p *payment_plan.Params) { p.DisbursementOnlyOnBusinessDays = v })
+		return err
+	})
+	return f
+}
+
+func (f *paramsFlags[T]) set(setter func(*payment_plan.Params)) {
+	f.setters = append(f.setters, func(t *T) { setter(f.params(t)) })
+}
+
+// setOuter registers a setter of a field of T itself, e.g. of the DownPaymentParams.
+func (f *paramsFlags[T]) setOuter(setter func(*T)) {
+	f.setters = append(f.setters, setter)
+}
+
+func (f *paramsFlags[T]) float(fs *flag.FlagSet, name string, usage string, setter func(*payment_plan.Params, float64)) {
+	fs.Func(name, usage, func(s string) error {
+		v, err := parseFloat(s)
+		f.set(func(p *payment_plan.Params) { setter(p, v) })
+		return err
+	})
+}
+
+func (f *paramsFlags[T]) uint(fs *flag.FlagSet, name string, usage string, setter func(*payment_plan.Params, uint32)) {
+	fs.Func(name, usage, func(s string) error {
+		v, err := parseUint32(s)
+		f.set(func(p *payment_plan.Params) { setter(p, v) })
+		return err
+	})
+}
+
+func (f *paramsFlags[T]) date(fs *flag.FlagSet, name string, usage string, setter func(*payment_plan.Params, time.Time)) {
+	fs.Func(name, usage+", YYYY-MM-DD", func(s string) error {
+		v, err := parseDate(s)
+		f.set(func(p *payment_plan.Params) { setter(p, v) })
+		return err
+	})
+}
+
+// load returns the params of the file, or the defaults without one, with the flags applied.
+func (f *paramsFlags[T]) load(stdin io.Reader, today time.Time) (T, error) {
+	var t T
+	p := f.params(&t)
+	if f.file == "" {
+		p.MaxTotalAmount = math.MaxFloat64
+	} else {
+		var r io.Reader = stdin
+		if f.file != "-" {
+			file, err := os.Open(f.file)
+			if err != nil {
+				return t, err
+			}
+			defer file.Close()
+			r = file
+		}
+		// Unknown fields are rejected like in the HTTP server, so a misspelled field isn't left at its zero value.
+		var data json.RawMessage
+		err := json.NewDecoder(r).Decode(&data)
+		if err == nil {
+			err = json.Unmarshal(data, &t)
+		}
+		if err == nil {
+			err = jsonfields.Unknown(data, &t)
+		}
+		if err != nil {
+			return t, fmt.Errorf("reading %s: %w", f.file, err)
+		}
+	}
+
+	for _, setter := range f.setters {
+		setter(&t)
+	}
+
+	if p.RequestedDate.IsZero() {
+		p.RequestedDate = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, dateLocation)
+	}
+	if p.FirstPaymentDate.IsZero() {
+		p.FirstPaymentDate = p.RequestedDate.AddDate(0, 1, 0)
+	}
+	return t, nil
+}
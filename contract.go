@@ -0,0 +1,141 @@
+package payment_plan
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Borrower is the issuer (emitente) of the credit note.
+type Borrower struct {
+	Name     string
+	Document string
+	Address  string
+}
+
+// ContractInstallment is an installment of the schedule of a contract summary.
+type ContractInstallment struct {
+	Number  int
+	DueDate time.Time
+	Amount  float64
+}
+
+// ContractSummary is the data available to contract templates.
+type ContractSummary struct {
+	Borrower Borrower
+	Params   Params
+	Response Response
+	Schedule []ContractInstallment
+}
+
+// DefaultContractTemplate is the pt-BR summary of a Cédula de Crédito Bancário (CCB).
+//
+// Contract templates are text/template sources rendered with a ContractSummary and the functions of the pt-BR Formatter
+// (currency, percent, date and number). Each line of the output is a line of the PDF: lines starting with "# " are titles,
+// lines starting with "## " are section headings and long lines are wrapped.
+const DefaultContractTemplate = `# Resumo da Cédula de Crédito Bancário
+
+## Emitente
+Nome: {{.Borrower.Name}}
+CPF/CNPJ: {{.Borrower.Document}}
+{{- if .Borrower.Address}}
+Endereço: {{.Borrower.Address}}
+{{- end}}
+
+## Valores
+Valor solicitado: {{currency .Params.RequestedAmount}}
+Valor liberado: {{currency .Response.PreDisbursementAmount}}
+IOF: {{currency .Response.TotalIof}}
+TAC: {{currency .Response.TacAmount}}
+Valor do contrato: {{currency .Response.ContractAmount}}
+Data de desembolso: {{date .Response.DisbursementDate}}
+
+## Taxas
+Taxa de juros: {{percent .Response.InterestRate}} a.m.
+Taxa efetiva de juros: {{percent .Response.EirMonthly}} a.m. ({{percent .Response.EirYearly}} a.a.)
+Custo Efetivo Total (CET): {{percent .Response.TecMonthly}} a.m. ({{percent .Response.TecYearly}} a.a.)
+
+## Cronograma de pagamento
+{{- range .Schedule}}
+Parcela {{.Number}}: vencimento em {{date .DueDate}}, valor de {{currency .Amount}}
+{{- end}}
+Total: {{.Response.Installment}} parcelas, {{currency .Response.TotalAmount}}
+`
+
+// NewContractTemplate parses a contract template, see DefaultContractTemplate for what is available to it.
+func NewContractTemplate(source string) (*template.Template, error) {
+	f, err := NewFormatter(LocalePtBR, Templates{})
+	if err != nil {
+		return nil, err
+	}
+	return template.New("contract").Funcs(f.funcs()).Parse(source)
+}
+
+var defaultContractTemplate = template.Must(NewContractTemplate(DefaultContractTemplate))
+
+// RenderContractSummaryPDF writes a PDF summarizing the amounts, schedule, IOF, TAC and CET of the accepted plan, using DefaultContractTemplate.
+// response must be one of the responses of CalculatePaymentPlan(params), the due dates of the schedule are the ones of the plan.
+func RenderContractSummaryPDF(w io.Writer, params Params, response Response, borrower Borrower) error {
+	return RenderContractSummaryPDFWithTemplate(w, defaultContractTemplate, params, response, borrower)
+}
+
+// RenderContractSummaryPDFWithTemplate works like RenderContractSummaryPDF, with a template created by NewContractTemplate.
+func RenderContractSummaryPDFWithTemplate(w io.Writer, tmpl *template.Template, params Params, response Response, borrower Borrower) error {
+	schedule, err := contractSchedule(params, response)
+	if err != nil {
+		return err
+	}
+	summary := ContractSummary{
+		Borrower: borrower,
+		Params:   params,
+		Response: response,
+		Schedule: schedule,
+	}
+
+	var text strings.Builder
+	if err := tmpl.Execute(&text, summary); err != nil {
+		return fmt.Errorf("rendering contract template: %w", err)
+	}
+
+	var doc pdfDocument
+	for _, line := range strings.Split(strings.TrimRight(text.String(), "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "# "):
+			doc.add(strings.TrimPrefix(line, "# "), 16, true)
+		case strings.HasPrefix(line, "## "):
+			doc.add(strings.TrimPrefix(line, "## "), 12, true)
+		default:
+			doc.add(line, 10, false)
+		}
+	}
+	return doc.writeTo(w)
+}
+
+// contractSchedule returns the installments of the response, due on the due dates of the plan of params,
+// since the response of n installments is due on the n-th due date.
+func contractSchedule(params Params, response Response) ([]ContractInstallment, error) {
+	plan, err := CalculatePaymentPlan(params)
+	if err != nil {
+		return nil, err
+	}
+	n := int(response.Installment)
+	if n == 0 || n > len(plan) {
+		return nil, fmt.Errorf("the plans of the params don't have %d installments: %w", n, ErrInvalidParams)
+	}
+	dueDates, err := scheduleOf(plan[:n])
+	if err != nil {
+		return nil, err
+	}
+	if toCivilDate(dueDates[n-1]) != toCivilDate(response.DueDate) {
+		return nil, fmt.Errorf("the response due on %s is not a plan of the params, due on %s: %w",
+			response.DueDate.Format(time.DateOnly), dueDates[n-1].Format(time.DateOnly), ErrInvalidParams)
+	}
+
+	schedule := make([]ContractInstallment, n)
+	for i, dueDate := range dueDates {
+		schedule[i] = ContractInstallment{Number: i + 1, DueDate: dueDate, Amount: response.InstallmentAmount}
+	}
+	return schedule, nil
+}
@@ -0,0 +1,90 @@
+package payment_plan_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+func TestRenderContractSummaryPDF(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+	plan, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	response := plan[1]
+
+	var buf bytes.Buffer
+	err = payment_plan.RenderContractSummaryPDF(&buf, params, response, payment_plan.Borrower{Name: "Maria (Silva)", Document: "123.456.789-09"})
+	if err != nil {
+		t.Fatalf("Error rendering PDF: %v", err)
+	}
+
+	pdf := buf.String()
+	if !strings.HasPrefix(pdf, "%PDF-1.4\n") || !strings.HasSuffix(pdf, "%%EOF\n") {
+		t.Errorf("Expected a PDF document")
+	}
+	for _, expected := range []string{
+		`(Resumo da C\351dula de Cr\351dito Banc\341rio)`,
+		`(Nome: Maria \(Silva\))`,
+		fmt.Sprintf(`(IOF: %s)`, payment_plan.FormatCurrency(response.TotalIof, payment_plan.LocalePtBR)),
+		`(Parcela 1: vencimento em 05/05/2025, valor de R$ 4.049,72)`,
+		`(Parcela 2: vencimento em 03/06/2025, valor de R$ 4.049,72)`,
+	} {
+		if !strings.Contains(pdf, expected) {
+			t.Errorf("Expected %s in PDF", expected)
+		}
+	}
+
+	tmpl, err := payment_plan.NewContractTemplate("# {{.Borrower.Name}}\n{{len .Schedule}} parcelas")
+	if err != nil {
+		t.Fatalf("Error parsing template: %v", err)
+	}
+	buf.Reset()
+	err = payment_plan.RenderContractSummaryPDFWithTemplate(&buf, tmpl, params, response, payment_plan.Borrower{Name: "Maria"})
+	if err != nil {
+		t.Fatalf("Error rendering PDF: %v", err)
+	}
+	if !strings.Contains(buf.String(), "/F2 16.0 Tf") || !strings.Contains(buf.String(), "(2 parcelas)") {
+		t.Errorf("Expected custom template in PDF, got %s", buf.String())
+	}
+}
+
+func TestContractSchedule(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+	plan, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	tmpl, err := payment_plan.NewContractTemplate("{{range .Schedule}}{{.Number}} {{date .DueDate}} {{currency .Amount}}\n{{end}}")
+	if err != nil {
+		t.Fatalf("Error parsing template: %v", err)
+	}
+
+	// Each row is due on the due date of the plan of as many installments, the native schedule of the accepted plan.
+	response := plan[len(plan)-1]
+	var buf bytes.Buffer
+	if err := payment_plan.RenderContractSummaryPDFWithTemplate(&buf, tmpl, params, response, payment_plan.Borrower{}); err != nil {
+		t.Fatalf("Error rendering PDF: %v", err)
+	}
+	for i, r := range plan {
+		expected := fmt.Sprintf("(%d %s %s)", i+1, payment_plan.FormatDate(r.DueDate, payment_plan.LocalePtBR),
+			payment_plan.FormatCurrency(response.InstallmentAmount, payment_plan.LocalePtBR))
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("Expected row %s in PDF", expected)
+		}
+	}
+
+	// A response of other params is not scheduled on the due dates of these.
+	other := response
+	other.DueDate = other.DueDate.AddDate(0, 1, 0)
+	err = payment_plan.RenderContractSummaryPDFWithTemplate(&buf, tmpl, params, other, payment_plan.Borrower{})
+	if !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams for a response of other params, got %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package payment_plan
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// DayCount is the convention used to turn the time between the disbursement and a due date into the exponent of the interest rate.
+type DayCount int
+
+const (
+	// Actual30 counts calendar days in months of 30 days, i.e. years of 360 days.
+	Actual30 DayCount = iota
+	// Thirty360 counts every month as 30 days and every year as 360 days (30E/360).
+	Thirty360
+	// Actual365 counts calendar days in years of 365 days.
+	Actual365
+	// Business252 counts business days in years of 252 days, the Brazilian market basis.
+	// This is the convention of the native library, whose discount factors are (1 + rate)^(-b/21) for b business days.
+	Business252
+)
+
+func (c DayCount) String() string {
+	switch c {
+	case Actual30:
+		return "ACT/30"
+	case Thirty360:
+		return "30/360"
+	case Actual365:
+		return "ACT/365"
+	case Business252:
+		return "BUS/252"
+	default:
+		return fmt.Sprintf("DayCount(%d)", int(c))
+	}
+}
+
+// Validate checks that the day count convention is known.
+func (c DayCount) Validate() error {
+	if c < Actual30 || c > Business252 {
+		return fmt.Errorf("unknown day count convention %v: %w", c, ErrInvalidParams)
+	}
+	return nil
+}
+
+// YearFractions returns the fraction of a year between from and each of the dates, using the convention.
+func (c DayCount) YearFractions(from time.Time, dates []time.Time) []float64 {
+	var nonBusinessDays map[civilDate]bool
+	if c == Business252 && len(dates) > 0 {
+		nonBusinessDays = dateSet(GetNonBusinessDaysBetween(from, dates[len(dates)-1]))
+	}
+
+	fractions := make([]float64, len(dates))
+	for i, date := range dates {
+		switch c {
+		case Thirty360:
+			fractions[i] = float64(days360(from, date)) / 360
+		case Actual365:
+			fractions[i] = float64(daysBetween(from, date)) / 365
+		case Business252:
+			fractions[i] = float64(businessDaysBetween(from, date, nonBusinessDays)) / 252
+		default:
+			fractions[i] = float64(daysBetween(from, date)) / 360
+		}
+	}
+	return fractions
+}
+
+// CalculatePaymentPlanWithDayCount calculates a payment plan using the given day count convention.
+//
+// The native library uses Business252, so with it the plan is the native one. With the other conventions, DaysIndex and
+// AccumulatedDaysIndex are the discount factors of the convention at params.InterestRate, (1 + rate)^(-12 * year fraction),
+// and the installments, IOF, contract amount, the amounts that depend on them and the effective rates are recalculated
+// for those factors the way the native library calculates its plans.
+func CalculatePaymentPlanWithDayCount(params Params, dayCount DayCount) ([]Response, error) {
+	if err := dayCount.Validate(); err != nil {
+		return nil, err
+	}
+
+	response, err := CalculatePaymentPlan(params)
+	if err != nil {
+		return nil, err
+	}
+	if dayCount == Business252 || len(response) == 0 {
+		return response, nil
+	}
+
+	disbursementDate := response[0].DisbursementDate
+	dueDates, err := scheduleOf(response)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := make([]scheduledDate, len(dueDates))
+	for i, fraction := range dayCount.YearFractions(disbursementDate, dueDates) {
+		schedule[i] = scheduledDate{
+			date:           dueDates[i],
+			days:           daysBetween(disbursementDate, dueDates[i]),
+			discountFactor: math.Pow(1+params.InterestRate, -12*fraction),
+		}
+	}
+
+	for i, r := range response {
+		response[i], _ = equalInstallments(schedule[:i+1]).recalculate(params, r)
+	}
+	return response, nil
+}
+
+// days360 counts the days between from and to using the 30E/360 convention.
+func days360(from time.Time, to time.Time) int64 {
+	a := toCivilDate(from)
+	b := toCivilDate(to)
+	return int64(360*(b.year-a.year) + 30*(int(b.month)-int(a.month)) + min(b.day, 30) - min(a.day, 30))
+}
+
+// businessDaysBetween counts the business days after from up to and including to.
+func businessDaysBetween(from time.Time, to time.Time, nonBusinessDays map[civilDate]bool) int64 {
+	var count int64
+	for date := from.AddDate(0, 0, 1); daysBetween(date, to) >= 0; date = date.AddDate(0, 0, 1) {
+		if !nonBusinessDays[toCivilDate(date)] {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,87 @@
+package payment_plan_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+func TestDayCountYearFractions(t *testing.T) {
+	from := time.Date(2025, 4, 7, 0, 0, 0, 0, time.FixedZone("-03", -3*60*60))
+	dates := []time.Time{
+		time.Date(2025, 5, 5, 0, 0, 0, 0, time.FixedZone("-03", -3*60*60)),
+		time.Date(2025, 5, 31, 0, 0, 0, 0, time.FixedZone("-03", -3*60*60)),
+	}
+
+	tests := []struct {
+		dayCount payment_plan.DayCount
+		expected []float64
+	}{
+		{payment_plan.Actual30, []float64{28.0 / 360, 54.0 / 360}},
+		{payment_plan.Thirty360, []float64{28.0 / 360, 53.0 / 360}},
+		{payment_plan.Actual365, []float64{28.0 / 365, 54.0 / 365}},
+		// 2025-04-18, 2025-04-21 and 2025-05-01 are holidays
+		{payment_plan.Business252, []float64{17.0 / 252, 36.0 / 252}},
+	}
+
+	for _, tt := range tests {
+		fractions := tt.dayCount.YearFractions(from, dates)
+		for i, fraction := range fractions {
+			if math.Abs(fraction-tt.expected[i]) > 1e-12 {
+				t.Errorf("%v date %d: Expected %v, got %v", tt.dayCount, i+1, tt.expected[i], fraction)
+			}
+		}
+	}
+}
+
+func TestCalculatePaymentPlanWithDayCount(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+
+	plain, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+
+	// BUS/252 is the convention of the native library, so its plan is the native one.
+	same, err := payment_plan.CalculatePaymentPlanWithDayCount(params, payment_plan.Business252)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	payment_plantest.AssertResponsesEqual(t, same, plain, payment_plantest.Exact)
+
+	resp, err := payment_plan.CalculatePaymentPlanWithDayCount(params, payment_plan.Actual365)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	if len(resp) != len(plain) {
+		t.Fatalf("Expected %d plans, got %d", len(plain), len(resp))
+	}
+	for i, r := range resp {
+		// 12 * days / 365 months instead of the business days over 21 of the native discount factors
+		expected := math.Pow(1.0235, -12*float64(plain[i].AccumulatedDays)/365)
+		if math.Abs(r.DaysIndex-expected) > 1e-12 {
+			t.Errorf("Installment %d: Expected DaysIndex %v, got %v", i+1, expected, r.DaysIndex)
+		}
+		// The effective rate counts calendar days in years of 365 days too, so it is the interest rate itself.
+		if r.EirMonthly != 0.0235 {
+			t.Errorf("Installment %d: Expected EirMonthly 0.0235, got %v", i+1, r.EirMonthly)
+		}
+	}
+	// 119 calendar days are 3.91 months, while the 81 business days of the native schedule are 3.86.
+	if r := resp[3]; r.InstallmentAmount != 2081.17 || r.TotalAmount != 8324.68 || r.TotalIof != 77.42 || r.ContractAmount != 7877.42 {
+		t.Errorf("Expected 4x of 2081.17, TotalAmount 8324.68, TotalIof 77.42 and ContractAmount 7877.42, got %v, %v, %v and %v",
+			r.InstallmentAmount, r.TotalAmount, r.TotalIof, r.ContractAmount)
+	}
+
+	// 28 calendar days are 0.93 months, while the 17 business days of the native schedule are 0.81.
+	actual30, err := payment_plan.CalculatePaymentPlanWithDayCount(params, payment_plan.Actual30)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	if r := actual30[0]; r.DaysIndex != math.Pow(1.0235, -28.0/30) || r.InstallmentAmount != 8019.84 || r.EirMonthly != 0.0238 {
+		t.Errorf("Expected 1x of 8019.84 with DaysIndex %v and EirMonthly 0.0238, got %v with %v and %v", math.Pow(1.0235, -28.0/30), r.InstallmentAmount, r.DaysIndex, r.EirMonthly)
+	}
+}
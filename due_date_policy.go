@@ -0,0 +1,221 @@
+package payment_plan
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// BusinessDayAdjustment tells how a due date that falls on a non-business day is moved.
+type BusinessDayAdjustment int
+
+const (
+	// NoAdjustment keeps due dates on non-business days.
+	NoAdjustment BusinessDayAdjustment = iota
+	// Following moves the due date to the next business day.
+	Following
+	// ModifiedFollowing moves the due date to the next business day, unless it is in the next month,
+	// in which case it is moved to the previous business day.
+	ModifiedFollowing
+	// Preceding moves the due date to the previous business day.
+	Preceding
+)
+
+func (a BusinessDayAdjustment) String() string {
+	switch a {
+	case NoAdjustment:
+		return "none"
+	case Following:
+		return "following"
+	case ModifiedFollowing:
+		return "modified_following"
+	case Preceding:
+		return "preceding"
+	default:
+		return fmt.Sprintf("BusinessDayAdjustment(%d)", int(a))
+	}
+}
+
+// DueDatePolicy describes how the due dates of a plan are laid out in the calendar.
+//
+// for example, payments always on the 10th, moved to the next business day and never in December:
+//
+//	DueDatePolicy{DayOfMonth: 10, Adjustment: Following, SkipMonths: []time.Month{time.December}}
+type DueDatePolicy struct {
+	// DayOfMonth is the day every installment is due. 0 keeps the day of Params.FirstPaymentDate.
+	// Days that don't exist in a month (e.g. 31) use the last day of that month.
+	DayOfMonth int
+	Adjustment BusinessDayAdjustment
+	// SkipMonths are months without installments.
+	SkipMonths []time.Month
+}
+
+// ScheduledResponse is a Response of a plan with a DueDatePolicy.
+//
+// DueDates holds every due date of the plan, DueDate is the last of them and AccumulatedDays is counted up to it.
+type ScheduledResponse struct {
+	Response
+	DueDates []time.Time
+}
+
+// Validate checks that the day of month and the skipped months are valid.
+func (p DueDatePolicy) Validate() error {
+	if p.DayOfMonth < 0 || p.DayOfMonth > 31 {
+		return fmt.Errorf("day of month %d must be between 0 and 31: %w", p.DayOfMonth, ErrInvalidParams)
+	}
+	if p.Adjustment < NoAdjustment || p.Adjustment > Preceding {
+		return fmt.Errorf("unknown business day adjustment %v: %w", p.Adjustment, ErrInvalidParams)
+	}
+	skipped := map[time.Month]bool{}
+	for _, month := range p.SkipMonths {
+		if month < time.January || month > time.December {
+			return fmt.Errorf("invalid skipped month %d: %w", month, ErrInvalidParams)
+		}
+		skipped[month] = true
+	}
+	if len(skipped) == 12 {
+		return fmt.Errorf("every month is skipped: %w", ErrInvalidParams)
+	}
+	return nil
+}
+
+// DueDates returns the due dates of a plan with the given number of installments, at 07:00 -03 like the due dates of the native library.
+// The first due date is the first date on or after firstPaymentDate that matches the policy, before the business day adjustment.
+// It returns nil if the policy is not valid.
+func (p DueDatePolicy) DueDates(firstPaymentDate time.Time, installments uint32) []time.Time {
+	if p.Validate() != nil {
+		return nil
+	}
+
+	day := p.DayOfMonth
+	if day == 0 {
+		day = firstPaymentDate.Day()
+	}
+
+	dates := make([]time.Time, 0, installments)
+	for month := 0; uint32(len(dates)) < installments; month++ {
+		candidate := atDay(addMonths(firstPaymentDate, month), day)
+		if candidate.Before(firstPaymentDate) || slices.Contains(p.SkipMonths, candidate.Month()) {
+			continue
+		}
+		dates = append(dates, candidate)
+	}
+
+	if p.Adjustment != NoAdjustment && len(dates) > 0 {
+		// A week of margin on both sides is enough to find the closest business day.
+		nonBusinessDays := dateSet(GetNonBusinessDaysBetween(dates[0].AddDate(0, 0, -7), dates[len(dates)-1].AddDate(0, 0, 7)))
+		for i, date := range dates {
+			dates[i] = p.adjust(date, nonBusinessDays)
+		}
+	}
+	for i, date := range dates {
+		dates[i] = nativeDate(date)
+	}
+	return dates
+}
+
+func (p DueDatePolicy) adjust(date time.Time, nonBusinessDays map[civilDate]bool) time.Time {
+	step := func(date time.Time, direction int) time.Time {
+		for nonBusinessDays[toCivilDate(date)] {
+			date = date.AddDate(0, 0, direction)
+		}
+		return date
+	}
+
+	switch p.Adjustment {
+	case Following:
+		return step(date, 1)
+	case ModifiedFollowing:
+		following := step(date, 1)
+		if following.Month() != date.Month() {
+			return step(date, -1)
+		}
+		return following
+	case Preceding:
+		return step(date, -1)
+	default:
+		return date
+	}
+}
+
+// CalculatePaymentPlanWithDueDatePolicy calculates a payment plan where the due dates follow the policy instead of monthly steps from params.FirstPaymentDate.
+//
+// DueDate, AccumulatedDays, DaysIndex and AccumulatedDaysIndex reflect the policy schedule, with the discount factors of the native library
+// over the business days up to each due date. The installments, IOF, contract amount, the amounts that depend on them and the effective rates
+// are recalculated for the policy schedule the way the native library calculates its plans.
+//
+// The plans are the installment counts of the native calculation, so MinInstallmentAmount and MaxTotalAmount are checked with its schedule.
+// It returns ErrInvalidParams if the first due date of the policy is not after the disbursement date, e.g. moved back to it by Preceding.
+func CalculatePaymentPlanWithDueDatePolicy(params Params, policy DueDatePolicy) ([]ScheduledResponse, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	response, err := CalculatePaymentPlan(params)
+	if err != nil {
+		return nil, err
+	}
+	if len(response) == 0 {
+		return nil, nil
+	}
+
+	disbursementDate := response[0].DisbursementDate
+	dueDates := policy.DueDates(params.FirstPaymentDate, response[len(response)-1].Installment)
+	if daysBetween(disbursementDate, dueDates[0]) <= 0 {
+		return nil, fmt.Errorf("the first due date %s of the policy is not after the disbursement date %s: %w",
+			dueDates[0].Format(time.DateOnly), disbursementDate.In(nativeLocation).Format(time.DateOnly), ErrInvalidParams)
+	}
+	schedule := businessDaySchedule(params.InterestRate, disbursementDate, dueDates)
+
+	result := make([]ScheduledResponse, len(response))
+	for i, r := range response {
+		n := r.Installment
+		r, _ = equalInstallments(schedule[:n]).recalculate(params, r)
+		result[i] = ScheduledResponse{Response: r, DueDates: slices.Clone(dueDates[:n])}
+	}
+	return result, nil
+}
+
+type civilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func toCivilDate(t time.Time) civilDate {
+	year, month, day := t.Date()
+	return civilDate{year, month, day}
+}
+
+func dateSet(dates []time.Time) map[civilDate]bool {
+	set := make(map[civilDate]bool, len(dates))
+	for _, date := range dates {
+		set[toCivilDate(date)] = true
+	}
+	return set
+}
+
+// nativeDate returns the day of t at 07:00 -03, the time of the dates of the native library.
+func nativeDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 7, 0, 0, 0, nativeLocation)
+}
+
+// atDay returns the given day of the month of t, or the last day of the month if it doesn't have that day.
+func atDay(t time.Time, day int) time.Time {
+	year, month, _ := t.Date()
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// daysBetween counts the calendar days from `from` to `to`, ignoring the time of the day.
+func daysBetween(from time.Time, to time.Time) int64 {
+	a := toCivilDate(from)
+	b := toCivilDate(to)
+	start := time.Date(a.year, a.month, a.day, 0, 0, 0, 0, time.UTC)
+	end := time.Date(b.year, b.month, b.day, 0, 0, 0, 0, time.UTC)
+	return int64(end.Sub(start).Hours() / 24)
+}
@@ -0,0 +1,140 @@
+package payment_plan_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+func TestDueDatePolicyDueDates(t *testing.T) {
+	policy := payment_plan.DueDatePolicy{
+		DayOfMonth: 21,
+		Adjustment: payment_plan.Following,
+		SkipMonths: []time.Month{time.May},
+	}
+
+	firstPaymentDate := time.Date(2025, 3, 25, 0, 0, 0, 0, time.FixedZone("-03", -3*60*60))
+	dueDates := policy.DueDates(firstPaymentDate, 3)
+
+	// 2025-04-21 is Tiradentes, so it is moved to the next business day, and May is skipped
+	expected := []time.Time{
+		time.Date(2025, 4, 22, 7, 0, 0, 0, time.FixedZone("-03", -3*60*60)),
+		time.Date(2025, 6, 23, 7, 0, 0, 0, time.FixedZone("-03", -3*60*60)),
+		time.Date(2025, 7, 21, 7, 0, 0, 0, time.FixedZone("-03", -3*60*60)),
+	}
+
+	if len(dueDates) != len(expected) {
+		t.Fatalf("Expected %d due dates, got %d", len(expected), len(dueDates))
+	}
+	for i, dueDate := range dueDates {
+		if !dueDate.Equal(expected[i]) {
+			t.Errorf("Due date %d: Expected %v, got %v", i+1, expected[i], dueDate)
+		}
+	}
+}
+
+func TestCalculatePaymentPlanWithDueDatePolicy(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+
+	plain, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+
+	// The schedule of the native library, so the plans are the native ones, up to the cent the IOF may differ by.
+	native, err := payment_plan.CalculatePaymentPlanWithDueDatePolicy(params, payment_plan.DueDatePolicy{Adjustment: payment_plan.Following})
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	if len(native) != len(plain) {
+		t.Fatalf("Expected %d plans, got %d", len(plain), len(native))
+	}
+	for i, r := range native {
+		payment_plantest.AssertResponseEqual(t, r.Response, plain[i], nativeScheduleTolerance)
+	}
+
+	resp, err := payment_plan.CalculatePaymentPlanWithDueDatePolicy(params, payment_plan.DueDatePolicy{SkipMonths: []time.Month{time.June}})
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+
+	for i, r := range resp {
+		if len(r.DueDates) != i+1 {
+			t.Fatalf("Installment %d: Expected %d due dates, got %d", i+1, i+1, len(r.DueDates))
+		}
+		if !r.DueDate.Equal(r.DueDates[i]) {
+			t.Errorf("Installment %d: Expected DueDate %v, got %v", i+1, r.DueDates[i], r.DueDate)
+		}
+		for _, dueDate := range r.DueDates {
+			if dueDate.Month() == time.June {
+				t.Errorf("Installment %d: Unexpected due date in June %v", i+1, dueDate)
+			}
+		}
+	}
+
+	// 2x is due on 05-03 and 07-03, 16 and 59 business days after the disbursement on 04-07, so the installment and IOF are
+	// the ones of those 87 days instead of the 57 of the native schedule.
+	r := resp[1]
+	expected := []time.Time{
+		time.Date(2025, 05, 3, 7, 0, 0, 0, payment_plantest.Location),
+		time.Date(2025, 07, 3, 7, 0, 0, 0, payment_plantest.Location),
+	}
+	if !r.DueDates[0].Equal(expected[0]) || !r.DueDates[1].Equal(expected[1]) {
+		t.Errorf("Expected due dates %v, got %v", expected, r.DueDates)
+	}
+	if r.AccumulatedDays != 87 {
+		t.Errorf("Expected AccumulatedDays 87, got %d", r.AccumulatedDays)
+	}
+	for _, c := range []struct {
+		field    string
+		got      float64
+		expected float64
+	}{
+		{"DaysIndex", r.DaysIndex, math.Pow(1.0235, -59.0/21)},
+		{"AccumulatedDaysIndex", r.AccumulatedDaysIndex, math.Pow(1.0235, -16.0/21) + math.Pow(1.0235, -59.0/21)},
+		{"InstallmentAmount", r.InstallmentAmount, 4098.52},
+		{"TotalAmount", r.TotalAmount, 8197.04},
+		{"TotalIof", r.TotalIof, 66.21},
+		{"ContractAmount", r.ContractAmount, 7866.21},
+		{"PaidContractAmount", r.PaidContractAmount, 7866.22},
+		{"EirMonthly", r.EirMonthly, 0.0226},
+		{"TecMonthly", r.TecMonthly, 0.0273},
+	} {
+		if math.Abs(c.got-c.expected) > 1e-9 {
+			t.Errorf("Expected %s %v, got %v", c.field, c.expected, c.got)
+		}
+	}
+
+	// 2025-04-19 is a Saturday after Good Friday, so Preceding moves the first due date back to the disbursement date.
+	params = payment_plantest.NewParams().Dates(payment_plantest.Date(2025, 04, 17), payment_plantest.Date(2025, 04, 19)).Build()
+	_, err = payment_plan.CalculatePaymentPlanWithDueDatePolicy(params, payment_plan.DueDatePolicy{Adjustment: payment_plan.Preceding})
+	if !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams for a first due date on the disbursement date, got %v", err)
+	}
+}
+
+// nativeScheduleTolerance allows the cent the IOF of the native library may differ by, and what it changes in the
+// installments, their sums and the effective rates.
+var nativeScheduleTolerance = payment_plantest.Tolerance{
+	Absolute: 1e-9,
+	Fields: map[string]payment_plantest.Tolerance{
+		"InstallmentAmount":          {Absolute: 0.011},
+		"CustomerAmount":             {Absolute: 0.011},
+		"TotalIof":                   {Absolute: 0.011},
+		"ContractAmount":             {Absolute: 0.011},
+		"TotalAmount":                {Absolute: 0.05},
+		"DebitService":               {Absolute: 0.05},
+		"CustomerDebitServiceAmount": {Absolute: 0.05},
+		"CalculationBasisForEffectiveInterestRate": {Absolute: 0.05},
+		"PreDisbursementAmount":                    {Absolute: 0.05},
+		"PaidTotalIof":                             {Absolute: 0.05},
+		"PaidContractAmount":                       {Absolute: 0.05},
+		"EirYearly":                                {Absolute: 1e-4},
+		"TecYearly":                                {Absolute: 1e-4},
+		"AccumulatedDaysIndex":                     {Absolute: 1e-9},
+	},
+}
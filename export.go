@@ -0,0 +1,221 @@
+package payment_plan
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Locale is the language and number format used to present values, e.g. in headers and decimal separators.
+type Locale string
+
+const (
+	LocaleEnUS Locale = "en-US"
+	LocalePtBR Locale = "pt-BR"
+)
+
+// DefaultColumns are the columns exported when no columns are selected.
+var DefaultColumns = []string{
+	"installment",
+	"due_date",
+	"installment_amount",
+	"total_amount",
+	"interest_rate",
+	"eir_monthly",
+	"tec_monthly",
+	"tec_yearly",
+	"total_iof",
+	"contract_amount",
+}
+
+// ptBRHeaders are the pt-BR headers of the Response columns, en-US headers are derived from the column names.
+var ptBRHeaders = map[string]string{
+	"installment":                    "Parcelas",
+	"due_date":                       "Vencimento",
+	"disbursement_date":              "Data de desembolso",
+	"accumulated_days":               "Dias acumulados",
+	"days_index":                     "Índice de dias",
+	"accumulated_days_index":         "Índice de dias acumulado",
+	"interest_rate":                  "Taxa de juros",
+	"installment_amount":             "Valor da parcela",
+	"installment_amount_without_tac": "Valor da parcela sem TAC",
+	"total_amount":                   "Valor total",
+	"debit_service":                  "Encargos",
+	"customer_debit_service_amount":  "Encargos do cliente",
+	"customer_amount":                "Valor do cliente",
+	"calculation_basis_for_effective_interest_rate": "Base de cálculo da taxa efetiva",
+	"merchant_debit_service_amount":                 "Encargos do lojista",
+	"merchant_total_amount":                         "Total do lojista",
+	"settled_to_merchant":                           "Repasse ao lojista",
+	"mdr_amount":                                    "Valor do MDR",
+	"effective_interest_rate":                       "Taxa efetiva de juros",
+	"total_effective_cost":                          "CET",
+	"eir_yearly":                                    "Taxa efetiva anual",
+	"tec_yearly":                                    "CET anual",
+	"eir_monthly":                                   "Taxa efetiva mensal",
+	"tec_monthly":                                   "CET mensal",
+	"total_iof":                                     "IOF total",
+	"contract_amount":                               "Valor do contrato",
+	"contract_amount_without_tac":                   "Valor do contrato sem TAC",
+	"tac_amount":                                    "Valor da TAC",
+	"iof_percentage":                                "IOF diário",
+	"overall_iof":                                   "IOF adicional",
+	"pre_disbursement_amount":                       "Valor pré-desembolso",
+	"paid_total_iof":                                "IOF total pago",
+	"paid_contract_amount":                          "Valor do contrato pago",
+}
+
+// CSVOptions configures WriteCSV.
+type CSVOptions struct {
+	// Columns are the JSON names of the Response fields to export (e.g. "installment_amount"), DefaultColumns if empty.
+	Columns []string
+	// Locale of the headers, numbers and dates, LocaleEnUS if empty.
+	Locale Locale
+	// Headers overrides the header of the given columns.
+	Headers map[string]string
+	// Comma is the field separator, ';' for LocalePtBR and ',' otherwise if zero.
+	Comma rune
+	// OmitHeader skips the header row.
+	OmitHeader bool
+}
+
+// rateColumns are the Response columns of rates and discount factors, formatted with rateDecimals.
+// The other float columns are amounts, formatted with two decimals.
+var rateColumns = map[string]bool{
+	"days_index":              true,
+	"accumulated_days_index":  true,
+	"interest_rate":           true,
+	"effective_interest_rate": true,
+	"total_effective_cost":    true,
+	"eir_yearly":              true,
+	"tec_yearly":              true,
+	"eir_monthly":             true,
+	"tec_monthly":             true,
+	"iof_percentage":          true,
+	"overall_iof":             true,
+}
+
+// rateDecimals are the decimals of the rates, enough for the daily IOF of 0.000082.
+const rateDecimals = 6
+
+// exportColumn is a Response field that can be exported.
+type exportColumn struct {
+	name     string
+	header   string
+	index    int
+	decimals int
+}
+
+func exportColumns(columns []string, locale Locale, headers map[string]string) ([]exportColumn, error) {
+	if len(columns) == 0 {
+		columns = DefaultColumns
+	}
+
+	fields := map[string]int{}
+	t := reflect.TypeOf(Response{})
+	for i := range t.NumField() {
+		fields[snakeCase(t.Field(i).Name)] = i
+	}
+
+	result := make([]exportColumn, len(columns))
+	for i, name := range columns {
+		index, ok := fields[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q: %w", name, ErrInvalidParams)
+		}
+		header, ok := headers[name]
+		if !ok {
+			header = columnHeader(name, locale)
+		}
+		decimals := 2
+		if rateColumns[name] {
+			decimals = rateDecimals
+		}
+		result[i] = exportColumn{name: name, header: header, index: index, decimals: decimals}
+	}
+	return result, nil
+}
+
+func columnHeader(name string, locale Locale) string {
+	if locale == LocalePtBR {
+		return ptBRHeaders[name]
+	}
+	words := strings.ReplaceAll(name, "_", " ")
+	for _, acronym := range []string{"iof", "tac", "mdr", "eir", "tec"} {
+		words = strings.ReplaceAll(" "+words+" ", " "+acronym+" ", " "+strings.ToUpper(acronym)+" ")
+		words = strings.TrimSpace(words)
+	}
+	return strings.ToUpper(words[:1]) + words[1:]
+}
+
+func (c exportColumn) value(r Response) any {
+	return reflect.ValueOf(r).Field(c.index).Interface()
+}
+
+// formatValue formats a Response field as text in the locale, floats rounded to the decimals with grouped thousands,
+// e.g. 1234.5599999999997 as "1.234,56" for LocalePtBR and "1,234.56" otherwise.
+func formatValue(value any, decimals int, locale Locale) string {
+	switch v := value.(type) {
+	case float64:
+		return FormatNumber(v, decimals, locale)
+	case time.Time:
+		if locale == LocalePtBR {
+			return v.Format("02/01/2006")
+		}
+		return v.Format("01/02/2006")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// WriteCSV writes one row for each response with the selected columns.
+//
+// for example, a pt-BR spreadsheet of the installment amounts:
+//
+//	WriteCSV(w, response, CSVOptions{Columns: []string{"installment", "installment_amount"}, Locale: LocalePtBR})
+//
+// writes
+//
+//	Parcelas;Valor da parcela
+//	1;7.996,80
+//	2;4.049,72
+func WriteCSV(w io.Writer, response []Response, options CSVOptions) error {
+	columns, err := exportColumns(options.Columns, options.Locale, options.Headers)
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Comma = options.Comma
+	if writer.Comma == 0 {
+		writer.Comma = ','
+		if options.Locale == LocalePtBR {
+			writer.Comma = ';'
+		}
+	}
+
+	row := make([]string, len(columns))
+	if !options.OmitHeader {
+		for i, c := range columns {
+			row[i] = c.header
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range response {
+		for i, c := range columns {
+			row[i] = formatValue(c.value(r), c.decimals, options.Locale)
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
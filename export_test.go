@@ -0,0 +1,132 @@
+package payment_plan_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+)
+
+var exportResponse = []payment_plan.Response{
+	{
+		Installment:       1,
+		DueDate:           time.Date(2025, 05, 5, 7, 0, 0, 0, time.FixedZone("-03", -3*60*60)),
+		InstallmentAmount: 7996.8,
+		TotalAmount:       7996.8,
+		TotalIof:          47.84,
+	},
+	{
+		Installment:       2,
+		DueDate:           time.Date(2025, 06, 3, 7, 0, 0, 0, time.FixedZone("-03", -3*60*60)),
+		InstallmentAmount: 4049.72,
+		TotalAmount:       8099.44,
+		TotalIof:          57.31,
+	},
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := payment_plan.WriteCSV(&buf, exportResponse, payment_plan.CSVOptions{
+		Columns: []string{"installment", "due_date", "installment_amount", "total_iof"},
+		Locale:  payment_plan.LocalePtBR,
+		Headers: map[string]string{"installment": "Qtd."},
+	})
+	if err != nil {
+		t.Fatalf("Error writing CSV: %v", err)
+	}
+
+	expected := "Qtd.;Vencimento;Valor da parcela;IOF total\n" +
+		"1;05/05/2025;7.996,80;47,84\n" +
+		"2;03/06/2025;4.049,72;57,31\n"
+	if buf.String() != expected {
+		t.Errorf("Expected\n%s\ngot\n%s", expected, buf.String())
+	}
+
+	buf.Reset()
+	err = payment_plan.WriteCSV(&buf, exportResponse, payment_plan.CSVOptions{Columns: []string{"installment", "total_iof"}})
+	if err != nil {
+		t.Fatalf("Error writing CSV: %v", err)
+	}
+	expected = "Installment,Total IOF\n1,47.84\n2,57.31\n"
+	if buf.String() != expected {
+		t.Errorf("Expected\n%s\ngot\n%s", expected, buf.String())
+	}
+
+	err = payment_plan.WriteCSV(&buf, exportResponse, payment_plan.CSVOptions{Columns: []string{"unknown"}})
+	if !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams, got %v", err)
+	}
+}
+
+func TestWriteCSVRounding(t *testing.T) {
+	// Amounts computed in float64 aren't representable in cents, and rates have more decimals than a spreadsheet shows.
+	response := []payment_plan.Response{{Installment: 1, InstallmentAmount: 242.1299999999996, TotalAmount: 1234.5599999999997, EirYearly: 0.32105412345}}
+	columns := []string{"installment", "installment_amount", "total_amount", "eir_yearly"}
+
+	for _, test := range []struct {
+		locale   payment_plan.Locale
+		expected string
+	}{
+		{payment_plan.LocalePtBR, "1;242,13;1.234,56;0,321054\n"},
+		{payment_plan.LocaleEnUS, "1,242.13,\"1,234.56\",0.321054\n"},
+	} {
+		var buf bytes.Buffer
+		err := payment_plan.WriteCSV(&buf, response, payment_plan.CSVOptions{Columns: columns, Locale: test.locale, OmitHeader: true})
+		if err != nil {
+			t.Fatalf("Error writing CSV: %v", err)
+		}
+		if buf.String() != test.expected {
+			t.Errorf("%s: Expected %q, got %q", test.locale, test.expected, buf.String())
+		}
+	}
+}
+
+func TestWriteXLSX(t *testing.T) {
+	sheet := xlsxSheet(t, exportResponse, payment_plan.XLSXOptions{Locale: payment_plan.LocalePtBR})
+	for _, expected := range []string{
+		`<c r="A1" t="inlineStr"><is><t>Parcelas</t></is></c>`,
+		`<c r="B2" s="1"><v>45782</v></c>`,
+		`<c r="C3"><v>4049.72</v></c>`,
+	} {
+		if !strings.Contains(sheet, expected) {
+			t.Errorf("Expected %s in sheet", expected)
+		}
+	}
+
+	// Amounts are rounded to cents and rates to six decimals, like in the CSV.
+	response := []payment_plan.Response{{DebitService: 148.96000000000018, DaysIndex: 0.981371965896169}}
+	sheet = xlsxSheet(t, response, payment_plan.XLSXOptions{Columns: []string{"debit_service", "days_index"}})
+	for _, expected := range []string{`<c r="A2"><v>148.96</v></c>`, `<c r="B2"><v>0.981372</v></c>`} {
+		if !strings.Contains(sheet, expected) {
+			t.Errorf("Expected %s in sheet %s", expected, sheet)
+		}
+	}
+}
+
+// xlsxSheet returns the worksheet of the spreadsheet written by WriteXLSX.
+func xlsxSheet(t *testing.T, response []payment_plan.Response, options payment_plan.XLSXOptions) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := payment_plan.WriteXLSX(&buf, response, options); err != nil {
+		t.Fatalf("Error writing XLSX: %v", err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Error reading XLSX: %v", err)
+	}
+	for _, f := range archive.File {
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			r, _ := f.Open()
+			data, _ := io.ReadAll(r)
+			return string(data)
+		}
+	}
+	t.Fatalf("Expected a worksheet in the XLSX")
+	return ""
+}
@@ -0,0 +1,178 @@
+package payment_plan
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Templates are the text/template sources used by a Formatter.
+// Short and Long render a Response, DownPaymentShort and DownPaymentLong render a DownPaymentResponse.
+//
+// Besides the fields of the value, templates can use the functions:
+//
+//	currency  formats an amount in BRL, e.g. R$ 1.234,56
+//	percent   formats a rate, e.g. 0.383782 as 38,38%
+//	date      formats a date, e.g. 05/05/2025
+//	number    formats a number with two decimals, e.g. 1.234,56
+type Templates struct {
+	Short            string
+	Long             string
+	DownPaymentShort string
+	DownPaymentLong  string
+}
+
+// DefaultTemplates are the templates of each locale.
+var DefaultTemplates = map[Locale]Templates{
+	LocalePtBR: {
+		Short: `{{.Installment}}x de {{currency .InstallmentAmount}} (CET {{percent .TecYearly}} a.a.)`,
+		Long: `{{.Installment}}x de {{currency .InstallmentAmount}}, total de {{currency .TotalAmount}}. ` +
+			`Último vencimento em {{date .DueDate}}. ` +
+			`Juros de {{percent .InterestRate}} a.m., CET de {{percent .TecMonthly}} a.m. ({{percent .TecYearly}} a.a.), IOF de {{currency .TotalIof}}.`,
+		DownPaymentShort: `Entrada de {{currency .TotalAmount}} em {{.InstallmentQuantity}}x de {{currency .InstallmentAmount}}`,
+		DownPaymentLong: `Entrada de {{currency .TotalAmount}} em {{.InstallmentQuantity}}x de {{currency .InstallmentAmount}}, ` +
+			`com primeiro pagamento em {{date .FirstPaymentDate}} e {{len .Plans}} opções de parcelamento do saldo.`,
+	},
+	LocaleEnUS: {
+		Short: `{{.Installment}}x of {{currency .InstallmentAmount}} (TEC {{percent .TecYearly}} p.a.)`,
+		Long: `{{.Installment}}x of {{currency .InstallmentAmount}}, {{currency .TotalAmount}} in total. ` +
+			`Last due date on {{date .DueDate}}. ` +
+			`Interest of {{percent .InterestRate}} p.m., TEC of {{percent .TecMonthly}} p.m. ({{percent .TecYearly}} p.a.), IOF of {{currency .TotalIof}}.`,
+		DownPaymentShort: `Down payment of {{currency .TotalAmount}} in {{.InstallmentQuantity}}x of {{currency .InstallmentAmount}}`,
+		DownPaymentLong: `Down payment of {{currency .TotalAmount}} in {{.InstallmentQuantity}}x of {{currency .InstallmentAmount}}, ` +
+			`first payment on {{date .FirstPaymentDate}} and {{len .Plans}} plan options for the remaining amount.`,
+	},
+}
+
+// Formatter renders responses as localized text, e.g. "12x de R$ 1.234,56 (CET 38,38% a.a.)".
+type Formatter struct {
+	locale           Locale
+	short            *template.Template
+	long             *template.Template
+	downPaymentShort *template.Template
+	downPaymentLong  *template.Template
+}
+
+// NewFormatter returns a Formatter for the locale. Empty fields of overrides use the DefaultTemplates of the locale.
+func NewFormatter(locale Locale, overrides Templates) (*Formatter, error) {
+	defaults, ok := DefaultTemplates[locale]
+	if !ok {
+		return nil, fmt.Errorf("unknown locale %q: %w", locale, ErrInvalidParams)
+	}
+
+	f := &Formatter{locale: locale}
+	templates := []struct {
+		name     string
+		target   **template.Template
+		override string
+		source   string
+	}{
+		{"short", &f.short, overrides.Short, defaults.Short},
+		{"long", &f.long, overrides.Long, defaults.Long},
+		{"down_payment_short", &f.downPaymentShort, overrides.DownPaymentShort, defaults.DownPaymentShort},
+		{"down_payment_long", &f.downPaymentLong, overrides.DownPaymentLong, defaults.DownPaymentLong},
+	}
+	for _, t := range templates {
+		source := t.source
+		if t.override != "" {
+			source = t.override
+		}
+		parsed, err := template.New(t.name).Funcs(f.funcs()).Parse(source)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s template: %w", t.name, err)
+		}
+		*t.target = parsed
+	}
+	return f, nil
+}
+
+func (f *Formatter) funcs() template.FuncMap {
+	return template.FuncMap{
+		"currency": func(v float64) string { return FormatCurrency(v, f.locale) },
+		"percent":  func(v float64) string { return FormatPercentage(v, f.locale) },
+		"date":     func(t time.Time) string { return FormatDate(t, f.locale) },
+		"number":   func(v float64) string { return FormatNumber(v, 2, f.locale) },
+	}
+}
+
+func render(t *template.Template, data any) (string, error) {
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// Short renders a one line summary of the response, fit for SMS and WhatsApp messages.
+func (f *Formatter) Short(r Response) (string, error) {
+	return render(f.short, r)
+}
+
+// Long renders a full description of the response, fit for emails.
+func (f *Formatter) Long(r Response) (string, error) {
+	return render(f.long, r)
+}
+
+// DownPaymentShort renders a one line summary of the down payment.
+func (f *Formatter) DownPaymentShort(r DownPaymentResponse) (string, error) {
+	return render(f.downPaymentShort, r)
+}
+
+// DownPaymentLong renders a full description of the down payment.
+func (f *Formatter) DownPaymentLong(r DownPaymentResponse) (string, error) {
+	return render(f.downPaymentLong, r)
+}
+
+// FormatCurrency formats an amount in BRL, "R$ 1.234,56" for LocalePtBR and "R$1,234.56" otherwise.
+func FormatCurrency(v float64, locale Locale) string {
+	sign := ""
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	if locale == LocalePtBR {
+		return sign + "R$ " + FormatNumber(v, 2, locale)
+	}
+	return sign + "R$" + FormatNumber(v, 2, locale)
+}
+
+// FormatPercentage formats a rate as a percentage with two decimals, e.g. 0.383782 as "38,38%" for LocalePtBR and "38.38%" otherwise.
+func FormatPercentage(v float64, locale Locale) string {
+	return FormatNumber(v*100, 2, locale) + "%"
+}
+
+// FormatDate formats a date as "02/01/2006" for LocalePtBR and "01/02/2006" otherwise.
+func FormatDate(t time.Time, locale Locale) string {
+	return formatValue(t, 0, locale)
+}
+
+// FormatNumber formats v rounded to the decimals, grouping thousands, e.g. 1234.5 with 2 decimals as "1.234,50" for LocalePtBR and "1,234.50" otherwise.
+func FormatNumber(v float64, decimals int, locale Locale) string {
+	decimalSeparator, groupSeparator := ".", ","
+	if locale == LocalePtBR {
+		decimalSeparator, groupSeparator = ",", "."
+	}
+
+	scale := math.Pow(10, float64(decimals))
+	s := strconv.FormatFloat(math.Round(math.Abs(v)*scale)/scale, 'f', decimals, 64)
+	integer, fraction, _ := strings.Cut(s, ".")
+
+	var b strings.Builder
+	if v < 0 && s != strconv.FormatFloat(0, 'f', decimals, 64) {
+		b.WriteByte('-')
+	}
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteString(groupSeparator)
+		}
+		b.WriteRune(digit)
+	}
+	if decimals > 0 {
+		b.WriteString(decimalSeparator)
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
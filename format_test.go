@@ -0,0 +1,90 @@
+package payment_plan_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+)
+
+func TestFormatCurrency(t *testing.T) {
+	tests := []struct {
+		value    float64
+		locale   payment_plan.Locale
+		expected string
+	}{
+		{1234.56, payment_plan.LocalePtBR, "R$ 1.234,56"},
+		{1234.56, payment_plan.LocaleEnUS, "R$1,234.56"},
+		{1234567.891, payment_plan.LocalePtBR, "R$ 1.234.567,89"},
+		{0.5, payment_plan.LocalePtBR, "R$ 0,50"},
+		{-47.84, payment_plan.LocalePtBR, "-R$ 47,84"},
+	}
+
+	for _, tt := range tests {
+		if got := payment_plan.FormatCurrency(tt.value, tt.locale); got != tt.expected {
+			t.Errorf("FormatCurrency(%v, %s): Expected %q, got %q", tt.value, tt.locale, tt.expected, got)
+		}
+	}
+
+	if got := payment_plan.FormatPercentage(0.383782, payment_plan.LocalePtBR); got != "38,38%" {
+		t.Errorf("Expected 38,38%%, got %q", got)
+	}
+	if got := payment_plan.FormatNumber(1234.5, 2, payment_plan.LocaleEnUS); got != "1,234.50" {
+		t.Errorf("Expected 1,234.50, got %q", got)
+	}
+}
+
+func TestFormatter(t *testing.T) {
+	response := payment_plan.Response{
+		Installment:       12,
+		DueDate:           time.Date(2026, 04, 6, 7, 0, 0, 0, time.FixedZone("-03", -3*60*60)),
+		InstallmentAmount: 1234.56,
+		TotalAmount:       14814.72,
+		InterestRate:      0.0235,
+		TecMonthly:        0.0274,
+		TecYearly:         0.383782,
+		TotalIof:          147.84,
+	}
+
+	f, err := payment_plan.NewFormatter(payment_plan.LocalePtBR, payment_plan.Templates{})
+	if err != nil {
+		t.Fatalf("Error creating formatter: %v", err)
+	}
+
+	short, err := f.Short(response)
+	if err != nil {
+		t.Fatalf("Error formatting: %v", err)
+	}
+	if short != "12x de R$ 1.234,56 (CET 38,38% a.a.)" {
+		t.Errorf("Expected short text, got %q", short)
+	}
+
+	long, err := f.Long(response)
+	if err != nil {
+		t.Fatalf("Error formatting: %v", err)
+	}
+	expected := "12x de R$ 1.234,56, total de R$ 14.814,72. Último vencimento em 06/04/2026. " +
+		"Juros de 2,35% a.m., CET de 2,74% a.m. (38,38% a.a.), IOF de R$ 147,84."
+	if long != expected {
+		t.Errorf("Expected %q, got %q", expected, long)
+	}
+
+	custom, err := payment_plan.NewFormatter(payment_plan.LocaleEnUS, payment_plan.Templates{Short: `{{.Installment}} x {{currency .InstallmentAmount}}`})
+	if err != nil {
+		t.Fatalf("Error creating formatter: %v", err)
+	}
+	short, _ = custom.Short(response)
+	if short != "12 x R$1,234.56" {
+		t.Errorf("Expected custom short text, got %q", short)
+	}
+
+	downPayment, _ := custom.DownPaymentShort(payment_plan.DownPaymentResponse{InstallmentAmount: 500, TotalAmount: 1000, InstallmentQuantity: 2})
+	if downPayment != "Down payment of R$1,000.00 in 2x of R$500.00" {
+		t.Errorf("Expected down payment text, got %q", downPayment)
+	}
+
+	if _, err := payment_plan.NewFormatter("fr-FR", payment_plan.Templates{}); !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams, got %v", err)
+	}
+}
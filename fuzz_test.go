@@ -0,0 +1,170 @@
+package payment_plan_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+// The fuzz targets run their seeds with go test, and search for params that break the invariants of the responses with:
+//
+//	go test -run '^$' -fuzz FuzzCalculatePaymentPlan -fuzztime 1m
+//
+// Dates are fuzzed as days after fuzzEpoch and numbers are kept in the ranges of ValidateParams,
+// so the fuzzer spends its time on params a service could send.
+
+var fuzzEpoch = payment_plantest.Date(2020, 01, 1)
+
+// maxFuzzInstallments bounds the installments, the calculation time grows with them.
+const maxFuzzInstallments = 48
+
+func fuzzDate(days uint16) time.Time {
+	return fuzzEpoch.AddDate(0, 0, int(days%(366*30)))
+}
+
+func finite(values ...float64) bool {
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) || math.Abs(v) > 1e12 {
+			return false
+		}
+	}
+	return true
+}
+
+// checkCalculationError fails unless err is one of the errors the package documents.
+func checkCalculationError(t *testing.T, err error) {
+	t.Helper()
+	if !errors.Is(err, payment_plan.ErrInvalidParams) && !errors.Is(err, payment_plan.ErrCalculationError) {
+		t.Fatalf("Expected ErrInvalidParams or ErrCalculationError, got %v", err)
+	}
+}
+
+func fuzzParams(amount float64, requested uint16, firstPayment uint8, installments uint8, debitService uint8, mdr float64, tac float64,
+	iofOverall float64, iofPercentage float64, interestRate float64, minInstallment float64, maxTotal float64, businessDays bool) (payment_plan.Params, bool) {
+	if !finite(amount, mdr, tac, iofOverall, iofPercentage, interestRate, minInstallment, maxTotal) {
+		return payment_plan.Params{}, false
+	}
+	requestedDate := fuzzDate(requested)
+	params := payment_plan.Params{
+		RequestedAmount:                amount,
+		RequestedDate:                  requestedDate,
+		FirstPaymentDate:               requestedDate.AddDate(0, 0, 1+int(firstPayment)),
+		Installments:                   1 + uint32(installments)%maxFuzzInstallments,
+		DebitServicePercentage:         uint16(debitService) % 101,
+		Mdr:                            mdr,
+		TacPercentage:                  tac,
+		IofOverall:                     iofOverall,
+		IofPercentage:                  iofPercentage,
+		InterestRate:                   interestRate,
+		MinInstallmentAmount:           minInstallment,
+		MaxTotalAmount:                 maxTotal,
+		DisbursementOnlyOnBusinessDays: businessDays,
+	}
+	return params, payment_plan.ValidateParams(params) == nil
+}
+
+func FuzzCalculatePaymentPlan(f *testing.F) {
+	f.Add(7800.0, uint16(1921), uint8(27), uint8(4), uint8(0), 0.05, 0.0, 0.0038, 0.000082, 0.0235, 100.0, 1000000.0, true)
+	f.Add(25000.0, uint16(2100), uint8(30), uint8(24), uint8(100), 0.1, 0.05, 0.0038, 0.000082, 0.0499, 50.0, 1000000.0, false)
+	f.Add(150.0, uint16(0), uint8(0), uint8(12), uint8(50), 0.0, 0.0, 0.0, 0.0, 0.0, 0.0, 1e9, true)
+
+	f.Fuzz(func(t *testing.T, amount float64, requested uint16, firstPayment uint8, installments uint8, debitService uint8, mdr float64, tac float64,
+		iofOverall float64, iofPercentage float64, interestRate float64, minInstallment float64, maxTotal float64, businessDays bool) {
+		params, ok := fuzzParams(amount, requested, firstPayment, installments, debitService, mdr, tac, iofOverall, iofPercentage, interestRate, minInstallment, maxTotal, businessDays)
+		if !ok {
+			t.Skip()
+		}
+
+		response, err := payment_plan.CalculatePaymentPlan(params)
+		if err != nil {
+			checkCalculationError(t, err)
+			return
+		}
+		if err := payment_plan.CheckInvariants(params, response); err != nil {
+			t.Errorf("Params %+v: %v", params, err)
+		}
+	})
+}
+
+func FuzzCalculateDownPaymentPlan(f *testing.F) {
+	f.Add(7800.0, 1000.0, uint8(4), uint16(1921), uint8(27), 0.0235, 100.0)
+	f.Add(12000.0, 3000.0, uint8(12), uint16(2100), uint8(0), 0.0499, 0.0)
+
+	f.Fuzz(func(t *testing.T, amount float64, downPayment float64, installments uint8, requested uint16, firstPayment uint8, interestRate float64, minInstallment float64) {
+		base := payment_plantest.NewParams().Amount(amount).InterestRate(interestRate)
+		base = base.Dates(fuzzDate(requested), fuzzDate(requested).AddDate(0, 0, 1+int(firstPayment)))
+		params := base.DownPayment(downPayment, 1+uint32(installments)%maxFuzzInstallments)
+		params.MinInstallmentAmount = minInstallment
+		if !finite(amount, downPayment, interestRate, minInstallment) || payment_plan.ValidateDownPaymentParams(params) != nil {
+			t.Skip()
+		}
+
+		response, err := payment_plan.CalculateDownPaymentPlan(params)
+		if err != nil {
+			checkCalculationError(t, err)
+			return
+		}
+		if err := payment_plan.CheckDownPaymentInvariants(params, response); err != nil {
+			t.Errorf("Params %+v: %v", params, err)
+		}
+	})
+}
+
+func FuzzDateFunctions(f *testing.F) {
+	f.Add(uint16(1919), uint8(5), uint8(6))
+	f.Add(uint16(1921), uint8(1), uint8(30))
+	f.Add(uint16(2190), uint8(20), uint8(0))
+
+	f.Fuzz(func(t *testing.T, base uint16, days uint8, span uint8) {
+		baseDate := fuzzDate(base)
+
+		// The native library returns the date at 07:00 -03, so days are compared instead of instants.
+		next := payment_plan.NextDisbursementDate(baseDate)
+		nextDay := payment_plantest.Date(next.Date())
+		if nextDay.Before(baseDate) {
+			t.Errorf("NextDisbursementDate(%v) = %v, expected the same day or a later one", baseDate, next)
+		}
+		if nonBusiness := payment_plan.GetNonBusinessDaysBetween(next, next); len(nonBusiness) != 0 {
+			t.Errorf("NextDisbursementDate(%v) = %v, which is not a business day", baseDate, next)
+		}
+		// A business day is its own disbursement date, except today, see TestNextDisbursementDate_NotToday.
+		today := payment_plantest.Date(time.Now().Date())
+		isBusinessDay := len(payment_plan.GetNonBusinessDaysBetween(baseDate, baseDate)) == 0
+		if isBusinessDay && !baseDate.Equal(today) && !nextDay.Equal(baseDate) {
+			t.Errorf("NextDisbursementDate(%v) = %v, expected the same business day", baseDate, next)
+		}
+
+		if days > 0 {
+			start, end := payment_plan.DisbursementDateRange(baseDate, uint32(days))
+			if end.Before(start) {
+				t.Fatalf("DisbursementDateRange(%v, %d) = (%v, %v), expected start before end", baseDate, days, start, end)
+			}
+			rangeDays := int(end.Sub(start).Hours()/24+0.5) + 1
+			if businessDays := rangeDays - len(payment_plan.GetNonBusinessDaysBetween(start, end)); businessDays != int(days) {
+				t.Errorf("DisbursementDateRange(%v, %d) = (%v, %v), which has %d business days", baseDate, days, start, end, businessDays)
+			}
+		}
+
+		endDate := baseDate.AddDate(0, 0, int(span))
+		nonBusiness := payment_plan.GetNonBusinessDaysBetween(baseDate, endDate)
+		found := map[string]bool{}
+		for i, day := range nonBusiness {
+			if payment_plantest.Date(day.Date()).Before(baseDate) || payment_plantest.Date(day.Date()).After(endDate) {
+				t.Errorf("GetNonBusinessDaysBetween(%v, %v) returned %v, out of the range", baseDate, endDate, day)
+			}
+			if i > 0 && !day.After(nonBusiness[i-1]) {
+				t.Errorf("GetNonBusinessDaysBetween(%v, %v) is not sorted: %v after %v", baseDate, endDate, day, nonBusiness[i-1])
+			}
+			found[day.Format(time.DateOnly)] = true
+		}
+		for day := baseDate; !day.After(endDate); day = day.AddDate(0, 0, 1) {
+			if (day.Weekday() == time.Saturday || day.Weekday() == time.Sunday) && !found[day.Format(time.DateOnly)] {
+				t.Errorf("GetNonBusinessDaysBetween(%v, %v) is missing the weekend day %v", baseDate, endDate, day)
+			}
+		}
+	})
+}
@@ -0,0 +1,184 @@
+package payment_plan_test
+
+import (
+	"encoding/json"
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+)
+
+// The golden files are regenerated from the native library with:
+//
+//	go test -run 'TestGolden' -update
+//
+// Review the diff of testdata/golden before committing it, every change is a change of the numbers the library returns.
+// A case is added by committing its NAME.params.json together with the NAME.golden.json generated from it, never the params alone.
+var update = flag.Bool("update", false, "regenerate the golden files of testdata/golden")
+
+const goldenDir = "testdata/golden"
+
+// tolerance is how far a number may be from its golden value: |got - want| <= max(Absolute, Relative * |want|).
+type tolerance struct {
+	Absolute float64 `json:"absolute"`
+	Relative float64 `json:"relative"`
+}
+
+// tolerances are read from testdata/golden/tolerances.json, Fields override Default by JSON field name.
+type tolerances struct {
+	Default tolerance            `json:"default"`
+	Fields  map[string]tolerance `json:"fields"`
+}
+
+func (t tolerances) equal(field string, got float64, want float64) bool {
+	tol, ok := t.Fields[field]
+	if !ok {
+		tol = t.Default
+	}
+	return math.Abs(got-want) <= math.Max(tol.Absolute, tol.Relative*math.Abs(want))
+}
+
+func readJSON(t *testing.T, path string, v any) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Error reading %s: %v", path, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatalf("Error decoding %s: %v", path, err)
+	}
+}
+
+func writeJSON(t *testing.T, path string, v any) {
+	t.Helper()
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		t.Fatalf("Error encoding %s: %v", path, err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		t.Fatalf("Error writing %s: %v", path, err)
+	}
+}
+
+// goldenCases returns the names of the cases of a directory, each one is a NAME.params.json with a NAME.golden.json.
+func goldenCases(t *testing.T, dir string) []string {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(goldenDir, dir, "*.params.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatalf("No cases in %s", filepath.Join(goldenDir, dir))
+	}
+	names := make([]string, len(paths))
+	for i, path := range paths {
+		names[i] = strings.TrimSuffix(filepath.Base(path), ".params.json")
+	}
+	return names
+}
+
+// checkGolden compares got with the golden file of the case, or rewrites it with -update.
+// Values are compared in their JSON encoding, so dates are compared by day and fields are reported by their JSON names.
+func checkGolden(t *testing.T, path string, got any, tols tolerances) {
+	t.Helper()
+	if *update {
+		writeJSON(t, path, got)
+		return
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		t.Fatalf("No golden file %s, generate it with -update", path)
+	}
+
+	var want any
+	readJSON(t, path, &want)
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("Error encoding response: %v", err)
+	}
+	var gotJSON any
+	if err := json.Unmarshal(data, &gotJSON); err != nil {
+		t.Fatalf("Error decoding response: %v", err)
+	}
+	compareJSON(t, "", gotJSON, want, tols)
+}
+
+func compareJSON(t *testing.T, path string, got any, want any, tols tolerances) {
+	t.Helper()
+	switch want := want.(type) {
+	case map[string]any:
+		gotMap, ok := got.(map[string]any)
+		if !ok {
+			t.Errorf("%s: Expected an object, got %v", path, got)
+			return
+		}
+		keys := make([]string, 0, len(want))
+		for key := range want {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			compareJSON(t, path+"."+key, gotMap[key], want[key], tols)
+		}
+	case []any:
+		gotSlice, ok := got.([]any)
+		if !ok || len(gotSlice) != len(want) {
+			t.Errorf("%s: Expected %d elements, got %v", path, len(want), got)
+			return
+		}
+		for i := range want {
+			compareJSON(t, path+"["+strconv.Itoa(i)+"]", gotSlice[i], want[i], tols)
+		}
+	case float64:
+		gotNumber, ok := got.(float64)
+		field := path[strings.LastIndex(path, ".")+1:]
+		if !ok || !tols.equal(field, gotNumber, want) {
+			t.Errorf("%s: Expected %v, got %v", path, want, got)
+		}
+	default:
+		if got != want {
+			t.Errorf("%s: Expected %v, got %v", path, want, got)
+		}
+	}
+}
+
+func TestGoldenPaymentPlans(t *testing.T) {
+	var tols tolerances
+	readJSON(t, filepath.Join(goldenDir, "tolerances.json"), &tols)
+
+	for _, name := range goldenCases(t, "plans") {
+		t.Run(name, func(t *testing.T) {
+			var params payment_plan.Params
+			readJSON(t, filepath.Join(goldenDir, "plans", name+".params.json"), &params)
+
+			response, err := payment_plan.CalculatePaymentPlan(params)
+			if err != nil {
+				t.Fatalf("Error calculating payment plan: %v", err)
+			}
+			checkGolden(t, filepath.Join(goldenDir, "plans", name+".golden.json"), response, tols)
+		})
+	}
+}
+
+func TestGoldenDownPaymentPlans(t *testing.T) {
+	var tols tolerances
+	readJSON(t, filepath.Join(goldenDir, "tolerances.json"), &tols)
+
+	for _, name := range goldenCases(t, "down_payments") {
+		t.Run(name, func(t *testing.T) {
+			var params payment_plan.DownPaymentParams
+			readJSON(t, filepath.Join(goldenDir, "down_payments", name+".params.json"), &params)
+
+			response, err := payment_plan.CalculateDownPaymentPlan(params)
+			if err != nil {
+				t.Fatalf("Error calculating down payment plan: %v", err)
+			}
+			checkGolden(t, filepath.Join(goldenDir, "down_payments", name+".golden.json"), response, tols)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package payment_plan
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// GraceMode tells what happens to the interest accrued during a grace period (carência).
+type GraceMode int
+
+const (
+	// GraceCapitalize adds the interest accrued during the grace period to the balance,
+	// so nothing is paid until the first amortizing installment.
+	GraceCapitalize GraceMode = iota
+	// GraceInterestOnly charges the interest accrued during the grace period as monthly interest-only installments,
+	// so the balance at the end of the grace period is the same as the contract amount.
+	GraceInterestOnly
+)
+
+func (m GraceMode) String() string {
+	switch m {
+	case GraceCapitalize:
+		return "capitalize"
+	case GraceInterestOnly:
+		return "interest_only"
+	default:
+		return fmt.Sprintf("GraceMode(%d)", int(m))
+	}
+}
+
+// GracePeriod delays the first amortizing installment by the given number of months.
+type GracePeriod struct {
+	Months uint32
+	Mode   GraceMode
+}
+
+// GraceInstallment is an interest-only installment paid during the grace period.
+type GraceInstallment struct {
+	DueDate time.Time
+	Amount  float64
+}
+
+// GraceResponse is a Response of a plan with a grace period.
+//
+// The embedded Response describes the amortizing installments, so its DueDate is the due date of the last amortizing installment,
+// and DaysIndex and AccumulatedDaysIndex are the discount factors of the amortizing installments from the disbursement.
+// TotalAmount, the debit service and the effective rates include the interest-only installments, if any.
+type GraceResponse struct {
+	Response
+	GracePeriod       GracePeriod
+	GraceInstallments []GraceInstallment
+}
+
+// Validate checks that the grace period mode is known.
+func (g GracePeriod) Validate() error {
+	if g.Mode != GraceCapitalize && g.Mode != GraceInterestOnly {
+		return fmt.Errorf("unknown grace period mode %v: %w", g.Mode, ErrInvalidParams)
+	}
+	return nil
+}
+
+// CalculatePaymentPlanWithGracePeriod calculates a payment plan where the first amortizing installment is delayed by the grace period.
+//
+// With GraceCapitalize the first amortizing installment is moved grace.Months months after params.FirstPaymentDate,
+// so the interest of the grace period is capitalized into the installments.
+//
+// With GraceInterestOnly the customer pays grace.Months interest-only installments on the due dates of the native schedule,
+// starting at the first one, and then the amortizing installments of the contract amount on the following due dates.
+// The interest is the one of the discount factors of the native library, over the business days of each period. The contract amount,
+// IOF, installments, the amounts that depend on them and the effective rates are recalculated for that cash flow the way the native
+// library calculates its plans, so the IOF of the principal counts the days of the grace period too.
+func CalculatePaymentPlanWithGracePeriod(params Params, grace GracePeriod) ([]GraceResponse, error) {
+	if err := grace.Validate(); err != nil {
+		return nil, err
+	}
+
+	if grace.Mode == GraceCapitalize {
+		params.FirstPaymentDate = addMonths(params.FirstPaymentDate, int(grace.Months))
+	}
+
+	response, err := CalculatePaymentPlan(params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]GraceResponse, len(response))
+	if grace.Mode == GraceCapitalize || grace.Months == 0 || len(response) == 0 {
+		for i, r := range response {
+			result[i] = GraceResponse{Response: r, GracePeriod: grace}
+		}
+		return result, nil
+	}
+
+	schedule, err := graceSchedule(params, response, grace.Months)
+	if err != nil {
+		return nil, err
+	}
+	for i, r := range response {
+		result[i] = interestOnlyGrace(params, r, grace, schedule[:int(grace.Months)+int(r.Installment)])
+	}
+	return result, nil
+}
+
+// graceSchedule returns the schedule of the longest plan with the interest-only installments of the grace period before it.
+// The due dates are the ones of the native plans, followed by the ones of the native schedule after them.
+func graceSchedule(params Params, response []Response, months uint32) ([]scheduledDate, error) {
+	dueDates, err := scheduleOf(response)
+	if err != nil {
+		return nil, err
+	}
+
+	native := dueDates[0]
+	extended := DueDatePolicy{Adjustment: Following}.DueDates(params.FirstPaymentDate, uint32(len(dueDates))+months)
+	for k := len(dueDates); k < len(extended); k++ {
+		year, month, day := extended[k].Date()
+		dueDates = append(dueDates, time.Date(year, month, day, native.Hour(), native.Minute(), native.Second(), native.Nanosecond(), native.Location()))
+	}
+	return businessDaySchedule(params.InterestRate, response[0].DisbursementDate, dueDates), nil
+}
+
+// interestOnlyGrace returns the plan of r with the interest-only installments of the grace period at the start of the schedule.
+// The balance is the contract amount up to the end of the grace period, and the amortizing installments pay it off from there.
+func interestOnlyGrace(params Params, r Response, grace GracePeriod, schedule []scheduledDate) GraceResponse {
+	months := int(grace.Months)
+	flow := cashFlow{
+		schedule: schedule,
+		first:    months,
+		installments: func(contractAmount float64) []float64 {
+			installments := make([]float64, len(schedule))
+			previousFactor := 1.0
+			for k := range months {
+				installments[k] = contractAmount * (previousFactor/schedule[k].discountFactor - 1)
+				previousFactor = schedule[k].discountFactor
+			}
+			installment := contractAmount * previousFactor / accumulatedDiscountFactor(schedule[months:])
+			for k := months; k < len(installments); k++ {
+				installments[k] = installment
+			}
+			return installments
+		},
+	}
+	r, amounts := flow.recalculate(params, r)
+
+	graceInstallments := make([]GraceInstallment, months)
+	for k := range graceInstallments {
+		graceInstallments[k] = GraceInstallment{DueDate: schedule[k].date, Amount: amounts[k]}
+	}
+	return GraceResponse{Response: r, GracePeriod: grace, GraceInstallments: graceInstallments}
+}
+
+// addMonths adds n months to t, keeping the day of the month when possible and
+// using the last day of the month otherwise (e.g. 01-31 + 1 month is 02-28).
+func addMonths(t time.Time, n int) time.Time {
+	year, month, day := t.Date()
+	firstOfMonth := time.Date(year, month+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
+
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
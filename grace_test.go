@@ -0,0 +1,102 @@
+package payment_plan_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+func TestCalculatePaymentPlanWithGracePeriod(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+
+	plain, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+
+	capitalized, err := payment_plan.CalculatePaymentPlanWithGracePeriod(params, payment_plan.GracePeriod{Months: 3, Mode: payment_plan.GraceCapitalize})
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	for i, r := range capitalized {
+		if len(r.GraceInstallments) != 0 {
+			t.Errorf("Installment %d: Expected no grace installments, got %d", i+1, len(r.GraceInstallments))
+		}
+		if r.TotalAmount <= plain[i].TotalAmount {
+			t.Errorf("Installment %d: Expected TotalAmount greater than %v, got %v", i+1, plain[i].TotalAmount, r.TotalAmount)
+		}
+	}
+	// 2025-08-03, three months after the first payment date, is a Sunday, so 1x is due on the 4th due date of the plain plan.
+	if r := capitalized[0]; !r.DueDate.Equal(plain[3].DueDate) || math.Abs(r.DaysIndex-plain[3].DaysIndex) > 1e-9 {
+		t.Errorf("Expected 1x due on %v with DaysIndex %v, got %v with %v", plain[3].DueDate, plain[3].DaysIndex, r.DueDate, r.DaysIndex)
+	}
+
+	interestOnly, err := payment_plan.CalculatePaymentPlanWithGracePeriod(params, payment_plan.GracePeriod{Months: 3, Mode: payment_plan.GraceInterestOnly})
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	if len(interestOnly) != len(plain) {
+		t.Fatalf("Expected %d plans, got %d", len(plain), len(interestOnly))
+	}
+	for i, r := range interestOnly {
+		if len(r.GraceInstallments) != 3 {
+			t.Fatalf("Installment %d: Expected 3 grace installments, got %d", i+1, len(r.GraceInstallments))
+		}
+		// The grace installments are due on the first three due dates of the plain plan, and the amortizing ones after them.
+		for k, g := range r.GraceInstallments {
+			if !g.DueDate.Equal(plain[k].DueDate) {
+				t.Errorf("Installment %d: Expected grace installment %d due on %v, got %v", i+1, k+1, plain[k].DueDate, g.DueDate)
+			}
+		}
+
+		graceTotal := 0.0
+		for _, g := range r.GraceInstallments {
+			graceTotal += g.Amount
+		}
+		if math.Abs(r.TotalAmount-(r.InstallmentAmount*float64(r.Installment)+graceTotal)) > 1e-9 {
+			t.Errorf("Installment %d: Expected TotalAmount %v, got %v", i+1, r.InstallmentAmount*float64(r.Installment)+graceTotal, r.TotalAmount)
+		}
+	}
+
+	// 1x pays off the contract amount on 2025-08-04, so the balance of the grace period is discounted from there.
+	one := interestOnly[0]
+	if !one.DueDate.Equal(plain[3].DueDate) || math.Abs(one.DaysIndex-plain[3].DaysIndex) > 1e-9 || math.Abs(one.AccumulatedDaysIndex-plain[3].DaysIndex) > 1e-9 {
+		t.Errorf("Expected 1x due on %v with DaysIndex %v, got %v with %v and AccumulatedDaysIndex %v",
+			plain[3].DueDate, plain[3].DaysIndex, one.DueDate, one.DaysIndex, one.AccumulatedDaysIndex)
+	}
+	expectedInstallment := math.Round(one.ContractAmount*plain[2].DaysIndex/plain[3].DaysIndex*100) / 100
+	if one.InstallmentAmount != expectedInstallment {
+		t.Errorf("Expected 1x of %v, got %v", expectedInstallment, one.InstallmentAmount)
+	}
+	// The interest of the first month is the one of the 17 business days up to 2025-05-05, like the discount factor of the plain 1x.
+	expectedInterest := math.Round(one.ContractAmount*(1/plain[0].DaysIndex-1)*100) / 100
+	if one.GraceInstallments[0].Amount != expectedInterest {
+		t.Errorf("Expected first grace installment of %v, got %v", expectedInterest, one.GraceInstallments[0].Amount)
+	}
+
+	// The IOF counts the days of the grace period, up to the amortizing installments.
+	four := interestOnly[3]
+	expectedGrace := []float64{150.67, 186.54, 186.54}
+	for k, g := range four.GraceInstallments {
+		if g.Amount != expectedGrace[k] {
+			t.Errorf("4x: Expected grace installment %d of %v, got %v", k+1, expectedGrace[k], g.Amount)
+		}
+	}
+	if four.InstallmentAmount != 2107.55 || four.TotalAmount != 8953.95 || four.TotalIof != 137.68 || four.ContractAmount != 7937.68 {
+		t.Errorf("Expected 4x of 2107.55, TotalAmount 8953.95, TotalIof 137.68 and ContractAmount 7937.68, got %v, %v, %v and %v",
+			four.InstallmentAmount, four.TotalAmount, four.TotalIof, four.ContractAmount)
+	}
+	if four.DueDate.Format(time.DateOnly) != "2025-11-03" || four.AccumulatedDays != 210 || four.EirMonthly != 0.0235 || four.TecMonthly != 0.027 {
+		t.Errorf("Expected 4x due on 2025-11-03, 210 days after the disbursement, with EirMonthly 0.0235 and TecMonthly 0.027, got %v, %d, %v and %v",
+			four.DueDate, four.AccumulatedDays, four.EirMonthly, four.TecMonthly)
+	}
+
+	_, err = payment_plan.CalculatePaymentPlanWithGracePeriod(params, payment_plan.GracePeriod{Months: 3, Mode: 7})
+	if !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams, got %v", err)
+	}
+}
@@ -0,0 +1,205 @@
+package paymentplanv1
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// dateLocation is the location of the dates converted from timestamps, the same one used by the native library.
+var dateLocation = time.FixedZone("-03", -3*60*60)
+
+// TimestampToTime converts a timestamp to a time in the -03 time zone. A nil timestamp is the zero time.
+func TimestampToTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime().In(dateLocation)
+}
+
+// TimeToTimestamp converts a time to a timestamp. The zero time is a nil timestamp.
+func TimeToTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// ParamsToProto converts the SDK params to their message.
+func ParamsToProto(p payment_plan.Params) *Params {
+	return &Params{
+		RequestedAmount:                p.RequestedAmount,
+		FirstPaymentDate:               TimeToTimestamp(p.FirstPaymentDate),
+		RequestedDate:                  TimeToTimestamp(p.RequestedDate),
+		Installments:                   p.Installments,
+		DebitServicePercentage:         uint32(p.DebitServicePercentage),
+		Mdr:                            p.Mdr,
+		TacPercentage:                  p.TacPercentage,
+		IofOverall:                     p.IofOverall,
+		IofPercentage:                  p.IofPercentage,
+		InterestRate:                   p.InterestRate,
+		MinInstallmentAmount:           p.MinInstallmentAmount,
+		MaxTotalAmount:                 p.MaxTotalAmount,
+		DisbursementOnlyOnBusinessDays: p.DisbursementOnlyOnBusinessDays,
+	}
+}
+
+// ParamsFromProto converts a message to the SDK params.
+// It returns an error wrapping payment_plan.ErrInvalidParams if debit_service_percentage doesn't fit the SDK type.
+func ParamsFromProto(p *Params) (payment_plan.Params, error) {
+	if p.GetDebitServicePercentage() > math.MaxUint16 {
+		return payment_plan.Params{}, fmt.Errorf("debit_service_percentage %d is out of range: %w", p.GetDebitServicePercentage(), payment_plan.ErrInvalidParams)
+	}
+	return payment_plan.Params{
+		RequestedAmount:                p.GetRequestedAmount(),
+		FirstPaymentDate:               TimestampToTime(p.GetFirstPaymentDate()),
+		RequestedDate:                  TimestampToTime(p.GetRequestedDate()),
+		Installments:                   p.GetInstallments(),
+		DebitServicePercentage:         uint16(p.GetDebitServicePercentage()),
+		Mdr:                            p.GetMdr(),
+		TacPercentage:                  p.GetTacPercentage(),
+		IofOverall:                     p.GetIofOverall(),
+		IofPercentage:                  p.GetIofPercentage(),
+		InterestRate:                   p.GetInterestRate(),
+		MinInstallmentAmount:           p.GetMinInstallmentAmount(),
+		MaxTotalAmount:                 p.GetMaxTotalAmount(),
+		DisbursementOnlyOnBusinessDays: p.GetDisbursementOnlyOnBusinessDays(),
+	}, nil
+}
+
+// ResponseToProto converts an SDK response to its message.
+func ResponseToProto(r payment_plan.Response) *Response {
+	return &Response{
+		Installment:                              r.Installment,
+		DueDate:                                  TimeToTimestamp(r.DueDate),
+		DisbursementDate:                         TimeToTimestamp(r.DisbursementDate),
+		AccumulatedDays:                          r.AccumulatedDays,
+		DaysIndex:                                r.DaysIndex,
+		AccumulatedDaysIndex:                     r.AccumulatedDaysIndex,
+		InterestRate:                             r.InterestRate,
+		InstallmentAmount:                        r.InstallmentAmount,
+		InstallmentAmountWithoutTac:              r.InstallmentAmountWithoutTac,
+		TotalAmount:                              r.TotalAmount,
+		DebitService:                             r.DebitService,
+		CustomerDebitServiceAmount:               r.CustomerDebitServiceAmount,
+		CustomerAmount:                           r.CustomerAmount,
+		CalculationBasisForEffectiveInterestRate: r.CalculationBasisForEffectiveInterestRate,
+		MerchantDebitServiceAmount:               r.MerchantDebitServiceAmount,
+		MerchantTotalAmount:                      r.MerchantTotalAmount,
+		SettledToMerchant:                        r.SettledToMerchant,
+		MdrAmount:                                r.MdrAmount,
+		EffectiveInterestRate:                    r.EffectiveInterestRate,
+		TotalEffectiveCost:                       r.TotalEffectiveCost,
+		EirYearly:                                r.EirYearly,
+		TecYearly:                                r.TecYearly,
+		EirMonthly:                               r.EirMonthly,
+		TecMonthly:                               r.TecMonthly,
+		TotalIof:                                 r.TotalIof,
+		ContractAmount:                           r.ContractAmount,
+		ContractAmountWithoutTac:                 r.ContractAmountWithoutTac,
+		TacAmount:                                r.TacAmount,
+		IofPercentage:                            r.IofPercentage,
+		OverallIof:                               r.OverallIof,
+		PreDisbursementAmount:                    r.PreDisbursementAmount,
+		PaidTotalIof:                             r.PaidTotalIof,
+		PaidContractAmount:                       r.PaidContractAmount,
+	}
+}
+
+// ResponseFromProto converts a message to an SDK response.
+func ResponseFromProto(r *Response) payment_plan.Response {
+	return payment_plan.Response{
+		Installment:                              r.GetInstallment(),
+		DueDate:                                  TimestampToTime(r.GetDueDate()),
+		DisbursementDate:                         TimestampToTime(r.GetDisbursementDate()),
+		AccumulatedDays:                          r.GetAccumulatedDays(),
+		DaysIndex:                                r.GetDaysIndex(),
+		AccumulatedDaysIndex:                     r.GetAccumulatedDaysIndex(),
+		InterestRate:                             r.GetInterestRate(),
+		InstallmentAmount:                        r.GetInstallmentAmount(),
+		InstallmentAmountWithoutTac:              r.GetInstallmentAmountWithoutTac(),
+		TotalAmount:                              r.GetTotalAmount(),
+		DebitService:                             r.GetDebitService(),
+		CustomerDebitServiceAmount:               r.GetCustomerDebitServiceAmount(),
+		CustomerAmount:                           r.GetCustomerAmount(),
+		CalculationBasisForEffectiveInterestRate: r.GetCalculationBasisForEffectiveInterestRate(),
+		MerchantDebitServiceAmount:               r.GetMerchantDebitServiceAmount(),
+		MerchantTotalAmount:                      r.GetMerchantTotalAmount(),
+		SettledToMerchant:                        r.GetSettledToMerchant(),
+		MdrAmount:                                r.GetMdrAmount(),
+		EffectiveInterestRate:                    r.GetEffectiveInterestRate(),
+		TotalEffectiveCost:                       r.GetTotalEffectiveCost(),
+		EirYearly:                                r.GetEirYearly(),
+		TecYearly:                                r.GetTecYearly(),
+		EirMonthly:                               r.GetEirMonthly(),
+		TecMonthly:                               r.GetTecMonthly(),
+		TotalIof:                                 r.GetTotalIof(),
+		ContractAmount:                           r.GetContractAmount(),
+		ContractAmountWithoutTac:                 r.GetContractAmountWithoutTac(),
+		TacAmount:                                r.GetTacAmount(),
+		IofPercentage:                            r.GetIofPercentage(),
+		OverallIof:                               r.GetOverallIof(),
+		PreDisbursementAmount:                    r.GetPreDisbursementAmount(),
+		PaidTotalIof:                             r.GetPaidTotalIof(),
+		PaidContractAmount:                       r.GetPaidContractAmount(),
+	}
+}
+
+// DownPaymentParamsToProto converts the SDK down payment params to their message.
+func DownPaymentParamsToProto(p payment_plan.DownPaymentParams) *DownPaymentParams {
+	return &DownPaymentParams{
+		Params:               ParamsToProto(p.Params),
+		RequestedAmount:      p.RequestedAmount,
+		MinInstallmentAmount: p.MinInstallmentAmount,
+		FirstPaymentDate:     TimeToTimestamp(p.FirstPaymentDate),
+		Installments:         p.Installments,
+	}
+}
+
+// DownPaymentParamsFromProto converts a message to the SDK down payment params, see ParamsFromProto.
+func DownPaymentParamsFromProto(p *DownPaymentParams) (payment_plan.DownPaymentParams, error) {
+	params, err := ParamsFromProto(p.GetParams())
+	if err != nil {
+		return payment_plan.DownPaymentParams{}, fmt.Errorf("params: %w", err)
+	}
+	return payment_plan.DownPaymentParams{
+		Params:               params,
+		RequestedAmount:      p.GetRequestedAmount(),
+		MinInstallmentAmount: p.GetMinInstallmentAmount(),
+		FirstPaymentDate:     TimestampToTime(p.GetFirstPaymentDate()),
+		Installments:         p.GetInstallments(),
+	}, nil
+}
+
+// DownPaymentResponseToProto converts an SDK down payment response to its message.
+func DownPaymentResponseToProto(r payment_plan.DownPaymentResponse) *DownPaymentResponse {
+	plans := make([]*Response, len(r.Plans))
+	for i, plan := range r.Plans {
+		plans[i] = ResponseToProto(plan)
+	}
+	return &DownPaymentResponse{
+		InstallmentAmount:   r.InstallmentAmount,
+		TotalAmount:         r.TotalAmount,
+		InstallmentQuantity: r.InstallmentQuantity,
+		FirstPaymentDate:    TimeToTimestamp(r.FirstPaymentDate),
+		Plans:               plans,
+	}
+}
+
+// DownPaymentResponseFromProto converts a message to an SDK down payment response.
+func DownPaymentResponseFromProto(r *DownPaymentResponse) payment_plan.DownPaymentResponse {
+	plans := make([]payment_plan.Response, len(r.GetPlans()))
+	for i, plan := range r.GetPlans() {
+		plans[i] = ResponseFromProto(plan)
+	}
+	return payment_plan.DownPaymentResponse{
+		InstallmentAmount:   r.GetInstallmentAmount(),
+		TotalAmount:         r.GetTotalAmount(),
+		InstallmentQuantity: r.GetInstallmentQuantity(),
+		FirstPaymentDate:    TimestampToTime(r.GetFirstPaymentDate()),
+		Plans:               plans,
+	}
+}
@@ -0,0 +1,75 @@
+package paymentplanv1_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/grpc/paymentplanv1"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestConvertParams(t *testing.T) {
+	params := payment_plantest.NewParams().MerchantPays(100).DownPayment(1000, 3)
+	params.FirstPaymentDate = payment_plantest.Date(2025, 04, 5)
+
+	message := paymentplanv1.DownPaymentParamsToProto(params)
+	// 2025-05-03 00:00 -03 is 2025-05-03 03:00 UTC.
+	if p := message.Params; p.RequestedAmount != 7800 || p.Installments != 4 || p.DebitServicePercentage != 100 || p.FirstPaymentDate.AsTime().Unix() != 1746241200 {
+		t.Errorf("Expected 7800 in 4 installments paid by the merchant from 2025-05-03, got %+v", p)
+	}
+	if message.RequestedAmount != 1000 || message.Installments != 3 || message.MinInstallmentAmount != 100 || message.FirstPaymentDate.AsTime().Unix() != 1743822000 {
+		t.Errorf("Expected a down payment of 1000 in 3 installments of at least 100 from 2025-04-05, got %+v", message)
+	}
+
+	// Times are compared with Equal, their locations are different values of the same zone.
+	got, err := paymentplanv1.DownPaymentParamsFromProto(message)
+	if err != nil {
+		t.Fatalf("Error converting params: %v", err)
+	}
+	if !proto.Equal(paymentplanv1.DownPaymentParamsToProto(got), message) {
+		t.Errorf("Expected %+v, got %+v", params, got)
+	}
+	if !got.Params.FirstPaymentDate.Equal(params.Params.FirstPaymentDate) || got.Params.FirstPaymentDate.Location().String() != "-03" {
+		t.Errorf("Expected first payment date %v, got %v", params.Params.FirstPaymentDate, got.Params.FirstPaymentDate)
+	}
+
+	message.Params.DebitServicePercentage = 70000
+	if _, err := paymentplanv1.DownPaymentParamsFromProto(message); !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams, got %v", err)
+	}
+}
+
+func TestConvertResponse(t *testing.T) {
+	location := time.FixedZone("-03", -3*60*60)
+	response := payment_plan.DownPaymentResponse{
+		InstallmentAmount:   333.33,
+		TotalAmount:         1000,
+		InstallmentQuantity: 3,
+		FirstPaymentDate:    time.Date(2025, 04, 5, 7, 0, 0, 0, location),
+		Plans: []payment_plan.Response{{
+			Installment:        1,
+			DueDate:            time.Date(2025, 05, 5, 7, 0, 0, 0, location),
+			DisbursementDate:   time.Date(2025, 04, 7, 7, 0, 0, 0, location),
+			AccumulatedDays:    28,
+			InstallmentAmount:  7996.8,
+			TecYearly:          0.383782,
+			PaidContractAmount: 7847.84,
+		}},
+	}
+
+	message := paymentplanv1.DownPaymentResponseToProto(response)
+	got := paymentplanv1.DownPaymentResponseFromProto(message)
+	if !proto.Equal(paymentplanv1.DownPaymentResponseToProto(got), message) {
+		t.Errorf("Expected %+v, got %+v", response, got)
+	}
+	if got.Plans[0].PaidContractAmount != 7847.84 || !got.Plans[0].DueDate.Equal(response.Plans[0].DueDate) {
+		t.Errorf("Expected plan %+v, got %+v", response.Plans[0], got.Plans[0])
+	}
+
+	if got := paymentplanv1.ResponseFromProto(nil); !got.DueDate.IsZero() || got.Installment != 0 {
+		t.Errorf("Expected the zero response, got %+v", got)
+	}
+}
@@ -0,0 +1,5 @@
+// Package paymentplanv1 holds the Go code generated from proto/payment_plan/v1/payment_plan.proto
+// and the conversions between its messages and the types of the payment_plan package.
+package paymentplanv1
+
+//go:generate protoc -I ../../proto --go_out=../.. --go_opt=module=github.com/ParceladoLara/payment-plan-go-sdk --go-grpc_out=../.. --go-grpc_opt=module=github.com/ParceladoLara/payment-plan-go-sdk payment_plan/v1/payment_plan.proto
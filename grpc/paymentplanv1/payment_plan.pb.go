@@ -0,0 +1,1282 @@
+// The payment plan calculator as a gRPC service. The messages mirror the types of the Go SDK field by field,
+// with dates as timestamps.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        v5.29.3
+// source: payment_plan/v1/payment_plan.proto
+
+package paymentplanv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Params struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RequestedAmount  float64                `protobuf:"fixed64,1,opt,name=requested_amount,json=requestedAmount,proto3" json:"requested_amount,omitempty"`
+	FirstPaymentDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=first_payment_date,json=firstPaymentDate,proto3" json:"first_payment_date,omitempty"`
+	RequestedDate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=requested_date,json=requestedDate,proto3" json:"requested_date,omitempty"`
+	Installments     uint32                 `protobuf:"varint,4,opt,name=installments,proto3" json:"installments,omitempty"`
+	// Only 0 to 100, it is an uint16 in the SDK.
+	DebitServicePercentage         uint32  `protobuf:"varint,5,opt,name=debit_service_percentage,json=debitServicePercentage,proto3" json:"debit_service_percentage,omitempty"`
+	Mdr                            float64 `protobuf:"fixed64,6,opt,name=mdr,proto3" json:"mdr,omitempty"`
+	TacPercentage                  float64 `protobuf:"fixed64,7,opt,name=tac_percentage,json=tacPercentage,proto3" json:"tac_percentage,omitempty"`
+	IofOverall                     float64 `protobuf:"fixed64,8,opt,name=iof_overall,json=iofOverall,proto3" json:"iof_overall,omitempty"`
+	IofPercentage                  float64 `protobuf:"fixed64,9,opt,name=iof_percentage,json=iofPercentage,proto3" json:"iof_percentage,omitempty"`
+	InterestRate                   float64 `protobuf:"fixed64,10,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	MinInstallmentAmount           float64 `protobuf:"fixed64,11,opt,name=min_installment_amount,json=minInstallmentAmount,proto3" json:"min_installment_amount,omitempty"`
+	MaxTotalAmount                 float64 `protobuf:"fixed64,12,opt,name=max_total_amount,json=maxTotalAmount,proto3" json:"max_total_amount,omitempty"`
+	DisbursementOnlyOnBusinessDays bool    `protobuf:"varint,13,opt,name=disbursement_only_on_business_days,json=disbursementOnlyOnBusinessDays,proto3" json:"disbursement_only_on_business_days,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetRequestedAmount() float64 {
+	if x != nil {
+		return x.RequestedAmount
+	}
+	return 0
+}
+
+func (x *Params) GetFirstPaymentDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstPaymentDate
+	}
+	return nil
+}
+
+func (x *Params) GetRequestedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedDate
+	}
+	return nil
+}
+
+func (x *Params) GetInstallments() uint32 {
+	if x != nil {
+		return x.Installments
+	}
+	return 0
+}
+
+func (x *Params) GetDebitServicePercentage() uint32 {
+	if x != nil {
+		return x.DebitServicePercentage
+	}
+	return 0
+}
+
+func (x *Params) GetMdr() float64 {
+	if x != nil {
+		return x.Mdr
+	}
+	return 0
+}
+
+func (x *Params) GetTacPercentage() float64 {
+	if x != nil {
+		return x.TacPercentage
+	}
+	return 0
+}
+
+func (x *Params) GetIofOverall() float64 {
+	if x != nil {
+		return x.IofOverall
+	}
+	return 0
+}
+
+func (x *Params) GetIofPercentage() float64 {
+	if x != nil {
+		return x.IofPercentage
+	}
+	return 0
+}
+
+func (x *Params) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *Params) GetMinInstallmentAmount() float64 {
+	if x != nil {
+		return x.MinInstallmentAmount
+	}
+	return 0
+}
+
+func (x *Params) GetMaxTotalAmount() float64 {
+	if x != nil {
+		return x.MaxTotalAmount
+	}
+	return 0
+}
+
+func (x *Params) GetDisbursementOnlyOnBusinessDays() bool {
+	if x != nil {
+		return x.DisbursementOnlyOnBusinessDays
+	}
+	return false
+}
+
+type Response struct {
+	state                                    protoimpl.MessageState `protogen:"open.v1"`
+	Installment                              uint32                 `protobuf:"varint,1,opt,name=installment,proto3" json:"installment,omitempty"`
+	DueDate                                  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	DisbursementDate                         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=disbursement_date,json=disbursementDate,proto3" json:"disbursement_date,omitempty"`
+	AccumulatedDays                          int64                  `protobuf:"varint,4,opt,name=accumulated_days,json=accumulatedDays,proto3" json:"accumulated_days,omitempty"`
+	DaysIndex                                float64                `protobuf:"fixed64,5,opt,name=days_index,json=daysIndex,proto3" json:"days_index,omitempty"`
+	AccumulatedDaysIndex                     float64                `protobuf:"fixed64,6,opt,name=accumulated_days_index,json=accumulatedDaysIndex,proto3" json:"accumulated_days_index,omitempty"`
+	InterestRate                             float64                `protobuf:"fixed64,7,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	InstallmentAmount                        float64                `protobuf:"fixed64,8,opt,name=installment_amount,json=installmentAmount,proto3" json:"installment_amount,omitempty"`
+	InstallmentAmountWithoutTac              float64                `protobuf:"fixed64,9,opt,name=installment_amount_without_tac,json=installmentAmountWithoutTac,proto3" json:"installment_amount_without_tac,omitempty"`
+	TotalAmount                              float64                `protobuf:"fixed64,10,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	DebitService                             float64                `protobuf:"fixed64,11,opt,name=debit_service,json=debitService,proto3" json:"debit_service,omitempty"`
+	CustomerDebitServiceAmount               float64                `protobuf:"fixed64,12,opt,name=customer_debit_service_amount,json=customerDebitServiceAmount,proto3" json:"customer_debit_service_amount,omitempty"`
+	CustomerAmount                           float64                `protobuf:"fixed64,13,opt,name=customer_amount,json=customerAmount,proto3" json:"customer_amount,omitempty"`
+	CalculationBasisForEffectiveInterestRate float64                `protobuf:"fixed64,14,opt,name=calculation_basis_for_effective_interest_rate,json=calculationBasisForEffectiveInterestRate,proto3" json:"calculation_basis_for_effective_interest_rate,omitempty"`
+	MerchantDebitServiceAmount               float64                `protobuf:"fixed64,15,opt,name=merchant_debit_service_amount,json=merchantDebitServiceAmount,proto3" json:"merchant_debit_service_amount,omitempty"`
+	MerchantTotalAmount                      float64                `protobuf:"fixed64,16,opt,name=merchant_total_amount,json=merchantTotalAmount,proto3" json:"merchant_total_amount,omitempty"`
+	SettledToMerchant                        float64                `protobuf:"fixed64,17,opt,name=settled_to_merchant,json=settledToMerchant,proto3" json:"settled_to_merchant,omitempty"`
+	MdrAmount                                float64                `protobuf:"fixed64,18,opt,name=mdr_amount,json=mdrAmount,proto3" json:"mdr_amount,omitempty"`
+	EffectiveInterestRate                    float64                `protobuf:"fixed64,19,opt,name=effective_interest_rate,json=effectiveInterestRate,proto3" json:"effective_interest_rate,omitempty"`
+	TotalEffectiveCost                       float64                `protobuf:"fixed64,20,opt,name=total_effective_cost,json=totalEffectiveCost,proto3" json:"total_effective_cost,omitempty"`
+	EirYearly                                float64                `protobuf:"fixed64,21,opt,name=eir_yearly,json=eirYearly,proto3" json:"eir_yearly,omitempty"`
+	TecYearly                                float64                `protobuf:"fixed64,22,opt,name=tec_yearly,json=tecYearly,proto3" json:"tec_yearly,omitempty"`
+	EirMonthly                               float64                `protobuf:"fixed64,23,opt,name=eir_monthly,json=eirMonthly,proto3" json:"eir_monthly,omitempty"`
+	TecMonthly                               float64                `protobuf:"fixed64,24,opt,name=tec_monthly,json=tecMonthly,proto3" json:"tec_monthly,omitempty"`
+	TotalIof                                 float64                `protobuf:"fixed64,25,opt,name=total_iof,json=totalIof,proto3" json:"total_iof,omitempty"`
+	ContractAmount                           float64                `protobuf:"fixed64,26,opt,name=contract_amount,json=contractAmount,proto3" json:"contract_amount,omitempty"`
+	ContractAmountWithoutTac                 float64                `protobuf:"fixed64,27,opt,name=contract_amount_without_tac,json=contractAmountWithoutTac,proto3" json:"contract_amount_without_tac,omitempty"`
+	TacAmount                                float64                `protobuf:"fixed64,28,opt,name=tac_amount,json=tacAmount,proto3" json:"tac_amount,omitempty"`
+	IofPercentage                            float64                `protobuf:"fixed64,29,opt,name=iof_percentage,json=iofPercentage,proto3" json:"iof_percentage,omitempty"`
+	OverallIof                               float64                `protobuf:"fixed64,30,opt,name=overall_iof,json=overallIof,proto3" json:"overall_iof,omitempty"`
+	PreDisbursementAmount                    float64                `protobuf:"fixed64,31,opt,name=pre_disbursement_amount,json=preDisbursementAmount,proto3" json:"pre_disbursement_amount,omitempty"`
+	PaidTotalIof                             float64                `protobuf:"fixed64,32,opt,name=paid_total_iof,json=paidTotalIof,proto3" json:"paid_total_iof,omitempty"`
+	PaidContractAmount                       float64                `protobuf:"fixed64,33,opt,name=paid_contract_amount,json=paidContractAmount,proto3" json:"paid_contract_amount,omitempty"`
+	unknownFields                            protoimpl.UnknownFields
+	sizeCache                                protoimpl.SizeCache
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Response) GetInstallment() uint32 {
+	if x != nil {
+		return x.Installment
+	}
+	return 0
+}
+
+func (x *Response) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Response) GetDisbursementDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisbursementDate
+	}
+	return nil
+}
+
+func (x *Response) GetAccumulatedDays() int64 {
+	if x != nil {
+		return x.AccumulatedDays
+	}
+	return 0
+}
+
+func (x *Response) GetDaysIndex() float64 {
+	if x != nil {
+		return x.DaysIndex
+	}
+	return 0
+}
+
+func (x *Response) GetAccumulatedDaysIndex() float64 {
+	if x != nil {
+		return x.AccumulatedDaysIndex
+	}
+	return 0
+}
+
+func (x *Response) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *Response) GetInstallmentAmount() float64 {
+	if x != nil {
+		return x.InstallmentAmount
+	}
+	return 0
+}
+
+func (x *Response) GetInstallmentAmountWithoutTac() float64 {
+	if x != nil {
+		return x.InstallmentAmountWithoutTac
+	}
+	return 0
+}
+
+func (x *Response) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *Response) GetDebitService() float64 {
+	if x != nil {
+		return x.DebitService
+	}
+	return 0
+}
+
+func (x *Response) GetCustomerDebitServiceAmount() float64 {
+	if x != nil {
+		return x.CustomerDebitServiceAmount
+	}
+	return 0
+}
+
+func (x *Response) GetCustomerAmount() float64 {
+	if x != nil {
+		return x.CustomerAmount
+	}
+	return 0
+}
+
+func (x *Response) GetCalculationBasisForEffectiveInterestRate() float64 {
+	if x != nil {
+		return x.CalculationBasisForEffectiveInterestRate
+	}
+	return 0
+}
+
+func (x *Response) GetMerchantDebitServiceAmount() float64 {
+	if x != nil {
+		return x.MerchantDebitServiceAmount
+	}
+	return 0
+}
+
+func (x *Response) GetMerchantTotalAmount() float64 {
+	if x != nil {
+		return x.MerchantTotalAmount
+	}
+	return 0
+}
+
+func (x *Response) GetSettledToMerchant() float64 {
+	if x != nil {
+		return x.SettledToMerchant
+	}
+	return 0
+}
+
+func (x *Response) GetMdrAmount() float64 {
+	if x != nil {
+		return x.MdrAmount
+	}
+	return 0
+}
+
+func (x *Response) GetEffectiveInterestRate() float64 {
+	if x != nil {
+		return x.EffectiveInterestRate
+	}
+	return 0
+}
+
+func (x *Response) GetTotalEffectiveCost() float64 {
+	if x != nil {
+		return x.TotalEffectiveCost
+	}
+	return 0
+}
+
+func (x *Response) GetEirYearly() float64 {
+	if x != nil {
+		return x.EirYearly
+	}
+	return 0
+}
+
+func (x *Response) GetTecYearly() float64 {
+	if x != nil {
+		return x.TecYearly
+	}
+	return 0
+}
+
+func (x *Response) GetEirMonthly() float64 {
+	if x != nil {
+		return x.EirMonthly
+	}
+	return 0
+}
+
+func (x *Response) GetTecMonthly() float64 {
+	if x != nil {
+		return x.TecMonthly
+	}
+	return 0
+}
+
+func (x *Response) GetTotalIof() float64 {
+	if x != nil {
+		return x.TotalIof
+	}
+	return 0
+}
+
+func (x *Response) GetContractAmount() float64 {
+	if x != nil {
+		return x.ContractAmount
+	}
+	return 0
+}
+
+func (x *Response) GetContractAmountWithoutTac() float64 {
+	if x != nil {
+		return x.ContractAmountWithoutTac
+	}
+	return 0
+}
+
+func (x *Response) GetTacAmount() float64 {
+	if x != nil {
+		return x.TacAmount
+	}
+	return 0
+}
+
+func (x *Response) GetIofPercentage() float64 {
+	if x != nil {
+		return x.IofPercentage
+	}
+	return 0
+}
+
+func (x *Response) GetOverallIof() float64 {
+	if x != nil {
+		return x.OverallIof
+	}
+	return 0
+}
+
+func (x *Response) GetPreDisbursementAmount() float64 {
+	if x != nil {
+		return x.PreDisbursementAmount
+	}
+	return 0
+}
+
+func (x *Response) GetPaidTotalIof() float64 {
+	if x != nil {
+		return x.PaidTotalIof
+	}
+	return 0
+}
+
+func (x *Response) GetPaidContractAmount() float64 {
+	if x != nil {
+		return x.PaidContractAmount
+	}
+	return 0
+}
+
+type DownPaymentParams struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Params               *Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	RequestedAmount      float64                `protobuf:"fixed64,2,opt,name=requested_amount,json=requestedAmount,proto3" json:"requested_amount,omitempty"`
+	MinInstallmentAmount float64                `protobuf:"fixed64,3,opt,name=min_installment_amount,json=minInstallmentAmount,proto3" json:"min_installment_amount,omitempty"`
+	FirstPaymentDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=first_payment_date,json=firstPaymentDate,proto3" json:"first_payment_date,omitempty"`
+	Installments         uint32                 `protobuf:"varint,5,opt,name=installments,proto3" json:"installments,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DownPaymentParams) Reset() {
+	*x = DownPaymentParams{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownPaymentParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownPaymentParams) ProtoMessage() {}
+
+func (x *DownPaymentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownPaymentParams.ProtoReflect.Descriptor instead.
+func (*DownPaymentParams) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DownPaymentParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *DownPaymentParams) GetRequestedAmount() float64 {
+	if x != nil {
+		return x.RequestedAmount
+	}
+	return 0
+}
+
+func (x *DownPaymentParams) GetMinInstallmentAmount() float64 {
+	if x != nil {
+		return x.MinInstallmentAmount
+	}
+	return 0
+}
+
+func (x *DownPaymentParams) GetFirstPaymentDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstPaymentDate
+	}
+	return nil
+}
+
+func (x *DownPaymentParams) GetInstallments() uint32 {
+	if x != nil {
+		return x.Installments
+	}
+	return 0
+}
+
+type DownPaymentResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	InstallmentAmount   float64                `protobuf:"fixed64,1,opt,name=installment_amount,json=installmentAmount,proto3" json:"installment_amount,omitempty"`
+	TotalAmount         float64                `protobuf:"fixed64,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	InstallmentQuantity uint32                 `protobuf:"varint,3,opt,name=installment_quantity,json=installmentQuantity,proto3" json:"installment_quantity,omitempty"`
+	FirstPaymentDate    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=first_payment_date,json=firstPaymentDate,proto3" json:"first_payment_date,omitempty"`
+	Plans               []*Response            `protobuf:"bytes,5,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DownPaymentResponse) Reset() {
+	*x = DownPaymentResponse{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownPaymentResponse) ProtoMessage() {}
+
+func (x *DownPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownPaymentResponse.ProtoReflect.Descriptor instead.
+func (*DownPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DownPaymentResponse) GetInstallmentAmount() float64 {
+	if x != nil {
+		return x.InstallmentAmount
+	}
+	return 0
+}
+
+func (x *DownPaymentResponse) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *DownPaymentResponse) GetInstallmentQuantity() uint32 {
+	if x != nil {
+		return x.InstallmentQuantity
+	}
+	return 0
+}
+
+func (x *DownPaymentResponse) GetFirstPaymentDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstPaymentDate
+	}
+	return nil
+}
+
+func (x *DownPaymentResponse) GetPlans() []*Response {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+type CalculatePaymentPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Params        *Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculatePaymentPlanRequest) Reset() {
+	*x = CalculatePaymentPlanRequest{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculatePaymentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePaymentPlanRequest) ProtoMessage() {}
+
+func (x *CalculatePaymentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePaymentPlanRequest.ProtoReflect.Descriptor instead.
+func (*CalculatePaymentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CalculatePaymentPlanRequest) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type CalculatePaymentPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Responses     []*Response            `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculatePaymentPlanResponse) Reset() {
+	*x = CalculatePaymentPlanResponse{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculatePaymentPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePaymentPlanResponse) ProtoMessage() {}
+
+func (x *CalculatePaymentPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePaymentPlanResponse.ProtoReflect.Descriptor instead.
+func (*CalculatePaymentPlanResponse) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CalculatePaymentPlanResponse) GetResponses() []*Response {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+type CalculateDownPaymentPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Params        *DownPaymentParams     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateDownPaymentPlanRequest) Reset() {
+	*x = CalculateDownPaymentPlanRequest{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateDownPaymentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateDownPaymentPlanRequest) ProtoMessage() {}
+
+func (x *CalculateDownPaymentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateDownPaymentPlanRequest.ProtoReflect.Descriptor instead.
+func (*CalculateDownPaymentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CalculateDownPaymentPlanRequest) GetParams() *DownPaymentParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type CalculateDownPaymentPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Responses     []*DownPaymentResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateDownPaymentPlanResponse) Reset() {
+	*x = CalculateDownPaymentPlanResponse{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateDownPaymentPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateDownPaymentPlanResponse) ProtoMessage() {}
+
+func (x *CalculateDownPaymentPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateDownPaymentPlanResponse.ProtoReflect.Descriptor instead.
+func (*CalculateDownPaymentPlanResponse) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CalculateDownPaymentPlanResponse) GetResponses() []*DownPaymentResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+type NextDisbursementDateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseDate      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=base_date,json=baseDate,proto3" json:"base_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextDisbursementDateRequest) Reset() {
+	*x = NextDisbursementDateRequest{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextDisbursementDateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextDisbursementDateRequest) ProtoMessage() {}
+
+func (x *NextDisbursementDateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextDisbursementDateRequest.ProtoReflect.Descriptor instead.
+func (*NextDisbursementDateRequest) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NextDisbursementDateRequest) GetBaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BaseDate
+	}
+	return nil
+}
+
+type NextDisbursementDateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextDisbursementDateResponse) Reset() {
+	*x = NextDisbursementDateResponse{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextDisbursementDateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextDisbursementDateResponse) ProtoMessage() {}
+
+func (x *NextDisbursementDateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextDisbursementDateResponse.ProtoReflect.Descriptor instead.
+func (*NextDisbursementDateResponse) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NextDisbursementDateResponse) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type DisbursementDateRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseDate      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=base_date,json=baseDate,proto3" json:"base_date,omitempty"`
+	Days          uint32                 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisbursementDateRangeRequest) Reset() {
+	*x = DisbursementDateRangeRequest{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisbursementDateRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisbursementDateRangeRequest) ProtoMessage() {}
+
+func (x *DisbursementDateRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisbursementDateRangeRequest.ProtoReflect.Descriptor instead.
+func (*DisbursementDateRangeRequest) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DisbursementDateRangeRequest) GetBaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BaseDate
+	}
+	return nil
+}
+
+func (x *DisbursementDateRangeRequest) GetDays() uint32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type DisbursementDateRangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisbursementDateRangeResponse) Reset() {
+	*x = DisbursementDateRangeResponse{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisbursementDateRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisbursementDateRangeResponse) ProtoMessage() {}
+
+func (x *DisbursementDateRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisbursementDateRangeResponse.ProtoReflect.Descriptor instead.
+func (*DisbursementDateRangeResponse) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DisbursementDateRangeResponse) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *DisbursementDateRangeResponse) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type GetNonBusinessDaysBetweenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNonBusinessDaysBetweenRequest) Reset() {
+	*x = GetNonBusinessDaysBetweenRequest{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNonBusinessDaysBetweenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNonBusinessDaysBetweenRequest) ProtoMessage() {}
+
+func (x *GetNonBusinessDaysBetweenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNonBusinessDaysBetweenRequest.ProtoReflect.Descriptor instead.
+func (*GetNonBusinessDaysBetweenRequest) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetNonBusinessDaysBetweenRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetNonBusinessDaysBetweenRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type GetNonBusinessDaysBetweenResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Dates         []*timestamppb.Timestamp `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNonBusinessDaysBetweenResponse) Reset() {
+	*x = GetNonBusinessDaysBetweenResponse{}
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNonBusinessDaysBetweenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNonBusinessDaysBetweenResponse) ProtoMessage() {}
+
+func (x *GetNonBusinessDaysBetweenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_plan_v1_payment_plan_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNonBusinessDaysBetweenResponse.ProtoReflect.Descriptor instead.
+func (*GetNonBusinessDaysBetweenResponse) Descriptor() ([]byte, []int) {
+	return file_payment_plan_v1_payment_plan_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetNonBusinessDaysBetweenResponse) GetDates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+var File_payment_plan_v1_payment_plan_proto protoreflect.FileDescriptor
+
+const file_payment_plan_v1_payment_plan_proto_rawDesc = "" +
+	"\n" +
+	"\"payment_plan/v1/payment_plan.proto\x12\x0fpayment_plan.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf0\x04\n" +
+	"\x06Params\x12)\n" +
+	"\x10requested_amount\x18\x01 \x01(\x01R\x0frequestedAmount\x12H\n" +
+	"\x12first_payment_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10firstPaymentDate\x12A\n" +
+	"\x0erequested_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rrequestedDate\x12\"\n" +
+	"\finstallments\x18\x04 \x01(\rR\finstallments\x128\n" +
+	"\x18debit_service_percentage\x18\x05 \x01(\rR\x16debitServicePercentage\x12\x10\n" +
+	"\x03mdr\x18\x06 \x01(\x01R\x03mdr\x12%\n" +
+	"\x0etac_percentage\x18\a \x01(\x01R\rtacPercentage\x12\x1f\n" +
+	"\viof_overall\x18\b \x01(\x01R\n" +
+	"iofOverall\x12%\n" +
+	"\x0eiof_percentage\x18\t \x01(\x01R\riofPercentage\x12#\n" +
+	"\rinterest_rate\x18\n" +
+	" \x01(\x01R\finterestRate\x124\n" +
+	"\x16min_installment_amount\x18\v \x01(\x01R\x14minInstallmentAmount\x12(\n" +
+	"\x10max_total_amount\x18\f \x01(\x01R\x0emaxTotalAmount\x12J\n" +
+	"\"disbursement_only_on_business_days\x18\r \x01(\bR\x1edisbursementOnlyOnBusinessDays\"\x86\f\n" +
+	"\bResponse\x12 \n" +
+	"\vinstallment\x18\x01 \x01(\rR\vinstallment\x125\n" +
+	"\bdue_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12G\n" +
+	"\x11disbursement_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x10disbursementDate\x12)\n" +
+	"\x10accumulated_days\x18\x04 \x01(\x03R\x0faccumulatedDays\x12\x1d\n" +
+	"\n" +
+	"days_index\x18\x05 \x01(\x01R\tdaysIndex\x124\n" +
+	"\x16accumulated_days_index\x18\x06 \x01(\x01R\x14accumulatedDaysIndex\x12#\n" +
+	"\rinterest_rate\x18\a \x01(\x01R\finterestRate\x12-\n" +
+	"\x12installment_amount\x18\b \x01(\x01R\x11installmentAmount\x12C\n" +
+	"\x1einstallment_amount_without_tac\x18\t \x01(\x01R\x1binstallmentAmountWithoutTac\x12!\n" +
+	"\ftotal_amount\x18\n" +
+	" \x01(\x01R\vtotalAmount\x12#\n" +
+	"\rdebit_service\x18\v \x01(\x01R\fdebitService\x12A\n" +
+	"\x1dcustomer_debit_service_amount\x18\f \x01(\x01R\x1acustomerDebitServiceAmount\x12'\n" +
+	"\x0fcustomer_amount\x18\r \x01(\x01R\x0ecustomerAmount\x12_\n" +
+	"-calculation_basis_for_effective_interest_rate\x18\x0e \x01(\x01R(calculationBasisForEffectiveInterestRate\x12A\n" +
+	"\x1dmerchant_debit_service_amount\x18\x0f \x01(\x01R\x1amerchantDebitServiceAmount\x122\n" +
+	"\x15merchant_total_amount\x18\x10 \x01(\x01R\x13merchantTotalAmount\x12.\n" +
+	"\x13settled_to_merchant\x18\x11 \x01(\x01R\x11settledToMerchant\x12\x1d\n" +
+	"\n" +
+	"mdr_amount\x18\x12 \x01(\x01R\tmdrAmount\x126\n" +
+	"\x17effective_interest_rate\x18\x13 \x01(\x01R\x15effectiveInterestRate\x120\n" +
+	"\x14total_effective_cost\x18\x14 \x01(\x01R\x12totalEffectiveCost\x12\x1d\n" +
+	"\n" +
+	"eir_yearly\x18\x15 \x01(\x01R\teirYearly\x12\x1d\n" +
+	"\n" +
+	"tec_yearly\x18\x16 \x01(\x01R\ttecYearly\x12\x1f\n" +
+	"\veir_monthly\x18\x17 \x01(\x01R\n" +
+	"eirMonthly\x12\x1f\n" +
+	"\vtec_monthly\x18\x18 \x01(\x01R\n" +
+	"tecMonthly\x12\x1b\n" +
+	"\ttotal_iof\x18\x19 \x01(\x01R\btotalIof\x12'\n" +
+	"\x0fcontract_amount\x18\x1a \x01(\x01R\x0econtractAmount\x12=\n" +
+	"\x1bcontract_amount_without_tac\x18\x1b \x01(\x01R\x18contractAmountWithoutTac\x12\x1d\n" +
+	"\n" +
+	"tac_amount\x18\x1c \x01(\x01R\ttacAmount\x12%\n" +
+	"\x0eiof_percentage\x18\x1d \x01(\x01R\riofPercentage\x12\x1f\n" +
+	"\voverall_iof\x18\x1e \x01(\x01R\n" +
+	"overallIof\x126\n" +
+	"\x17pre_disbursement_amount\x18\x1f \x01(\x01R\x15preDisbursementAmount\x12$\n" +
+	"\x0epaid_total_iof\x18  \x01(\x01R\fpaidTotalIof\x120\n" +
+	"\x14paid_contract_amount\x18! \x01(\x01R\x12paidContractAmount\"\x93\x02\n" +
+	"\x11DownPaymentParams\x12/\n" +
+	"\x06params\x18\x01 \x01(\v2\x17.payment_plan.v1.ParamsR\x06params\x12)\n" +
+	"\x10requested_amount\x18\x02 \x01(\x01R\x0frequestedAmount\x124\n" +
+	"\x16min_installment_amount\x18\x03 \x01(\x01R\x14minInstallmentAmount\x12H\n" +
+	"\x12first_payment_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x10firstPaymentDate\x12\"\n" +
+	"\finstallments\x18\x05 \x01(\rR\finstallments\"\x95\x02\n" +
+	"\x13DownPaymentResponse\x12-\n" +
+	"\x12installment_amount\x18\x01 \x01(\x01R\x11installmentAmount\x12!\n" +
+	"\ftotal_amount\x18\x02 \x01(\x01R\vtotalAmount\x121\n" +
+	"\x14installment_quantity\x18\x03 \x01(\rR\x13installmentQuantity\x12H\n" +
+	"\x12first_payment_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x10firstPaymentDate\x12/\n" +
+	"\x05plans\x18\x05 \x03(\v2\x19.payment_plan.v1.ResponseR\x05plans\"N\n" +
+	"\x1bCalculatePaymentPlanRequest\x12/\n" +
+	"\x06params\x18\x01 \x01(\v2\x17.payment_plan.v1.ParamsR\x06params\"W\n" +
+	"\x1cCalculatePaymentPlanResponse\x127\n" +
+	"\tresponses\x18\x01 \x03(\v2\x19.payment_plan.v1.ResponseR\tresponses\"]\n" +
+	"\x1fCalculateDownPaymentPlanRequest\x12:\n" +
+	"\x06params\x18\x01 \x01(\v2\".payment_plan.v1.DownPaymentParamsR\x06params\"f\n" +
+	" CalculateDownPaymentPlanResponse\x12B\n" +
+	"\tresponses\x18\x01 \x03(\v2$.payment_plan.v1.DownPaymentResponseR\tresponses\"V\n" +
+	"\x1bNextDisbursementDateRequest\x127\n" +
+	"\tbase_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bbaseDate\"N\n" +
+	"\x1cNextDisbursementDateResponse\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"k\n" +
+	"\x1cDisbursementDateRangeRequest\x127\n" +
+	"\tbase_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bbaseDate\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\rR\x04days\"\x7f\n" +
+	"\x1dDisbursementDateRangeResponse\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"\x94\x01\n" +
+	" GetNonBusinessDaysBetweenRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"U\n" +
+	"!GetNonBusinessDaysBetweenResponse\x120\n" +
+	"\x05dates\x18\x01 \x03(\v2\x1a.google.protobuf.TimestampR\x05dates2\xfc\x04\n" +
+	"\x12PaymentPlanService\x12s\n" +
+	"\x14CalculatePaymentPlan\x12,.payment_plan.v1.CalculatePaymentPlanRequest\x1a-.payment_plan.v1.CalculatePaymentPlanResponse\x12\x7f\n" +
+	"\x18CalculateDownPaymentPlan\x120.payment_plan.v1.CalculateDownPaymentPlanRequest\x1a1.payment_plan.v1.CalculateDownPaymentPlanResponse\x12s\n" +
+	"\x14NextDisbursementDate\x12,.payment_plan.v1.NextDisbursementDateRequest\x1a-.payment_plan.v1.NextDisbursementDateResponse\x12v\n" +
+	"\x15DisbursementDateRange\x12-.payment_plan.v1.DisbursementDateRangeRequest\x1a..payment_plan.v1.DisbursementDateRangeResponse\x12\x82\x01\n" +
+	"\x19GetNonBusinessDaysBetween\x121.payment_plan.v1.GetNonBusinessDaysBetweenRequest\x1a2.payment_plan.v1.GetNonBusinessDaysBetweenResponseBOZMgithub.com/ParceladoLara/payment-plan-go-sdk/grpc/paymentplanv1;paymentplanv1b\x06proto3"
+
+var (
+	file_payment_plan_v1_payment_plan_proto_rawDescOnce sync.Once
+	file_payment_plan_v1_payment_plan_proto_rawDescData []byte
+)
+
+func file_payment_plan_v1_payment_plan_proto_rawDescGZIP() []byte {
+	file_payment_plan_v1_payment_plan_proto_rawDescOnce.Do(func() {
+		file_payment_plan_v1_payment_plan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_payment_plan_v1_payment_plan_proto_rawDesc), len(file_payment_plan_v1_payment_plan_proto_rawDesc)))
+	})
+	return file_payment_plan_v1_payment_plan_proto_rawDescData
+}
+
+var file_payment_plan_v1_payment_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_payment_plan_v1_payment_plan_proto_goTypes = []any{
+	(*Params)(nil),                            // 0: payment_plan.v1.Params
+	(*Response)(nil),                          // 1: payment_plan.v1.Response
+	(*DownPaymentParams)(nil),                 // 2: payment_plan.v1.DownPaymentParams
+	(*DownPaymentResponse)(nil),               // 3: payment_plan.v1.DownPaymentResponse
+	(*CalculatePaymentPlanRequest)(nil),       // 4: payment_plan.v1.CalculatePaymentPlanRequest
+	(*CalculatePaymentPlanResponse)(nil),      // 5: payment_plan.v1.CalculatePaymentPlanResponse
+	(*CalculateDownPaymentPlanRequest)(nil),   // 6: payment_plan.v1.CalculateDownPaymentPlanRequest
+	(*CalculateDownPaymentPlanResponse)(nil),  // 7: payment_plan.v1.CalculateDownPaymentPlanResponse
+	(*NextDisbursementDateRequest)(nil),       // 8: payment_plan.v1.NextDisbursementDateRequest
+	(*NextDisbursementDateResponse)(nil),      // 9: payment_plan.v1.NextDisbursementDateResponse
+	(*DisbursementDateRangeRequest)(nil),      // 10: payment_plan.v1.DisbursementDateRangeRequest
+	(*DisbursementDateRangeResponse)(nil),     // 11: payment_plan.v1.DisbursementDateRangeResponse
+	(*GetNonBusinessDaysBetweenRequest)(nil),  // 12: payment_plan.v1.GetNonBusinessDaysBetweenRequest
+	(*GetNonBusinessDaysBetweenResponse)(nil), // 13: payment_plan.v1.GetNonBusinessDaysBetweenResponse
+	(*timestamppb.Timestamp)(nil),             // 14: google.protobuf.Timestamp
+}
+var file_payment_plan_v1_payment_plan_proto_depIdxs = []int32{
+	14, // 0: payment_plan.v1.Params.first_payment_date:type_name -> google.protobuf.Timestamp
+	14, // 1: payment_plan.v1.Params.requested_date:type_name -> google.protobuf.Timestamp
+	14, // 2: payment_plan.v1.Response.due_date:type_name -> google.protobuf.Timestamp
+	14, // 3: payment_plan.v1.Response.disbursement_date:type_name -> google.protobuf.Timestamp
+	0,  // 4: payment_plan.v1.DownPaymentParams.params:type_name -> payment_plan.v1.Params
+	14, // 5: payment_plan.v1.DownPaymentParams.first_payment_date:type_name -> google.protobuf.Timestamp
+	14, // 6: payment_plan.v1.DownPaymentResponse.first_payment_date:type_name -> google.protobuf.Timestamp
+	1,  // 7: payment_plan.v1.DownPaymentResponse.plans:type_name -> payment_plan.v1.Response
+	0,  // 8: payment_plan.v1.CalculatePaymentPlanRequest.params:type_name -> payment_plan.v1.Params
+	1,  // 9: payment_plan.v1.CalculatePaymentPlanResponse.responses:type_name -> payment_plan.v1.Response
+	2,  // 10: payment_plan.v1.CalculateDownPaymentPlanRequest.params:type_name -> payment_plan.v1.DownPaymentParams
+	3,  // 11: payment_plan.v1.CalculateDownPaymentPlanResponse.responses:type_name -> payment_plan.v1.DownPaymentResponse
+	14, // 12: payment_plan.v1.NextDisbursementDateRequest.base_date:type_name -> google.protobuf.Timestamp
+	14, // 13: payment_plan.v1.NextDisbursementDateResponse.date:type_name -> google.protobuf.Timestamp
+	14, // 14: payment_plan.v1.DisbursementDateRangeRequest.base_date:type_name -> google.protobuf.Timestamp
+	14, // 15: payment_plan.v1.DisbursementDateRangeResponse.start:type_name -> google.protobuf.Timestamp
+	14, // 16: payment_plan.v1.DisbursementDateRangeResponse.end:type_name -> google.protobuf.Timestamp
+	14, // 17: payment_plan.v1.GetNonBusinessDaysBetweenRequest.start_date:type_name -> google.protobuf.Timestamp
+	14, // 18: payment_plan.v1.GetNonBusinessDaysBetweenRequest.end_date:type_name -> google.protobuf.Timestamp
+	14, // 19: payment_plan.v1.GetNonBusinessDaysBetweenResponse.dates:type_name -> google.protobuf.Timestamp
+	4,  // 20: payment_plan.v1.PaymentPlanService.CalculatePaymentPlan:input_type -> payment_plan.v1.CalculatePaymentPlanRequest
+	6,  // 21: payment_plan.v1.PaymentPlanService.CalculateDownPaymentPlan:input_type -> payment_plan.v1.CalculateDownPaymentPlanRequest
+	8,  // 22: payment_plan.v1.PaymentPlanService.NextDisbursementDate:input_type -> payment_plan.v1.NextDisbursementDateRequest
+	10, // 23: payment_plan.v1.PaymentPlanService.DisbursementDateRange:input_type -> payment_plan.v1.DisbursementDateRangeRequest
+	12, // 24: payment_plan.v1.PaymentPlanService.GetNonBusinessDaysBetween:input_type -> payment_plan.v1.GetNonBusinessDaysBetweenRequest
+	5,  // 25: payment_plan.v1.PaymentPlanService.CalculatePaymentPlan:output_type -> payment_plan.v1.CalculatePaymentPlanResponse
+	7,  // 26: payment_plan.v1.PaymentPlanService.CalculateDownPaymentPlan:output_type -> payment_plan.v1.CalculateDownPaymentPlanResponse
+	9,  // 27: payment_plan.v1.PaymentPlanService.NextDisbursementDate:output_type -> payment_plan.v1.NextDisbursementDateResponse
+	11, // 28: payment_plan.v1.PaymentPlanService.DisbursementDateRange:output_type -> payment_plan.v1.DisbursementDateRangeResponse
+	13, // 29: payment_plan.v1.PaymentPlanService.GetNonBusinessDaysBetween:output_type -> payment_plan.v1.GetNonBusinessDaysBetweenResponse
+	25, // [25:30] is the sub-list for method output_type
+	20, // [20:25] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_payment_plan_v1_payment_plan_proto_init() }
+func file_payment_plan_v1_payment_plan_proto_init() {
+	if File_payment_plan_v1_payment_plan_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_plan_v1_payment_plan_proto_rawDesc), len(file_payment_plan_v1_payment_plan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_payment_plan_v1_payment_plan_proto_goTypes,
+		DependencyIndexes: file_payment_plan_v1_payment_plan_proto_depIdxs,
+		MessageInfos:      file_payment_plan_v1_payment_plan_proto_msgTypes,
+	}.Build()
+	File_payment_plan_v1_payment_plan_proto = out.File
+	file_payment_plan_v1_payment_plan_proto_goTypes = nil
+	file_payment_plan_v1_payment_plan_proto_depIdxs = nil
+}
@@ -0,0 +1,187 @@
+// Package server implements the PaymentPlanService of proto/payment_plan/v1 with the payment_plan package.
+//
+// A service can run it on its own gRPC server:
+//
+//	s := grpc.NewServer()
+//	server.Register(s)
+//	s.Serve(listener)
+//
+// or use Serve, which also registers the standard health service and stops gracefully.
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/grpc/paymentplanv1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server implements paymentplanv1.PaymentPlanServiceServer.
+type Server struct {
+	paymentplanv1.UnimplementedPaymentPlanServiceServer
+}
+
+// New returns a Server.
+func New() *Server {
+	return &Server{}
+}
+
+// Register registers a Server on s.
+func Register(s grpc.ServiceRegistrar) {
+	paymentplanv1.RegisterPaymentPlanServiceServer(s, New())
+}
+
+// Serve serves the PaymentPlanService and the health service on listener until ctx is done,
+// then stops gracefully, waiting for in-flight calls.
+func Serve(ctx context.Context, listener net.Listener, options ...grpc.ServerOption) error {
+	s := grpc.NewServer(options...)
+	Register(s)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+		healthServer.Shutdown()
+		s.GracefulStop()
+	}()
+
+	err := s.Serve(listener)
+	if ctx.Err() != nil {
+		<-stopped
+		return nil
+	}
+	return err
+}
+
+// toStatus maps the errors of the package to gRPC statuses.
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, payment_plan.ErrInvalidParams):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, payment_plan.ErrCalculationError):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (s *Server) CalculatePaymentPlan(ctx context.Context, request *paymentplanv1.CalculatePaymentPlanRequest) (*paymentplanv1.CalculatePaymentPlanResponse, error) {
+	params, err := paymentplanv1.ParamsFromProto(request.GetParams())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if err := requiredParamsDates("params", params); err != nil {
+		return nil, err
+	}
+	if err := payment_plan.ValidateParams(params); err != nil {
+		return nil, toStatus(err)
+	}
+	response, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	responses := make([]*paymentplanv1.Response, len(response))
+	for i, r := range response {
+		responses[i] = paymentplanv1.ResponseToProto(r)
+	}
+	return &paymentplanv1.CalculatePaymentPlanResponse{Responses: responses}, nil
+}
+
+func (s *Server) CalculateDownPaymentPlan(ctx context.Context, request *paymentplanv1.CalculateDownPaymentPlanRequest) (*paymentplanv1.CalculateDownPaymentPlanResponse, error) {
+	params, err := paymentplanv1.DownPaymentParamsFromProto(request.GetParams())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if err := requiredParamsDates("params.params", params.Params); err != nil {
+		return nil, err
+	}
+	if err := requiredDate("params.first_payment_date", params.FirstPaymentDate); err != nil {
+		return nil, err
+	}
+	if err := payment_plan.ValidateDownPaymentParams(params); err != nil {
+		return nil, toStatus(err)
+	}
+	response, err := payment_plan.CalculateDownPaymentPlan(params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	responses := make([]*paymentplanv1.DownPaymentResponse, len(response))
+	for i, r := range response {
+		responses[i] = paymentplanv1.DownPaymentResponseToProto(r)
+	}
+	return &paymentplanv1.CalculateDownPaymentPlanResponse{Responses: responses}, nil
+}
+
+// requiredDate checks that a required timestamp field of a request is set.
+func requiredDate(field string, date time.Time) error {
+	if date.IsZero() {
+		return status.Errorf(codes.InvalidArgument, "%s is required", field)
+	}
+	return nil
+}
+
+// requiredParamsDates checks that the dates of the params, a field of a request, are set.
+func requiredParamsDates(field string, params payment_plan.Params) error {
+	if err := requiredDate(field+".first_payment_date", params.FirstPaymentDate); err != nil {
+		return err
+	}
+	return requiredDate(field+".requested_date", params.RequestedDate)
+}
+
+func (s *Server) NextDisbursementDate(ctx context.Context, request *paymentplanv1.NextDisbursementDateRequest) (*paymentplanv1.NextDisbursementDateResponse, error) {
+	baseDate := paymentplanv1.TimestampToTime(request.GetBaseDate())
+	if err := requiredDate("base_date", baseDate); err != nil {
+		return nil, err
+	}
+	date := payment_plan.NextDisbursementDate(baseDate)
+	return &paymentplanv1.NextDisbursementDateResponse{Date: paymentplanv1.TimeToTimestamp(date)}, nil
+}
+
+func (s *Server) DisbursementDateRange(ctx context.Context, request *paymentplanv1.DisbursementDateRangeRequest) (*paymentplanv1.DisbursementDateRangeResponse, error) {
+	baseDate := paymentplanv1.TimestampToTime(request.GetBaseDate())
+	if err := requiredDate("base_date", baseDate); err != nil {
+		return nil, err
+	}
+	if request.GetDays() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "days must be positive")
+	}
+	start, end := payment_plan.DisbursementDateRange(baseDate, request.GetDays())
+	return &paymentplanv1.DisbursementDateRangeResponse{
+		Start: paymentplanv1.TimeToTimestamp(start),
+		End:   paymentplanv1.TimeToTimestamp(end),
+	}, nil
+}
+
+func (s *Server) GetNonBusinessDaysBetween(ctx context.Context, request *paymentplanv1.GetNonBusinessDaysBetweenRequest) (*paymentplanv1.GetNonBusinessDaysBetweenResponse, error) {
+	startDate := paymentplanv1.TimestampToTime(request.GetStartDate())
+	endDate := paymentplanv1.TimestampToTime(request.GetEndDate())
+	if err := requiredDate("start_date", startDate); err != nil {
+		return nil, err
+	}
+	if err := requiredDate("end_date", endDate); err != nil {
+		return nil, err
+	}
+	if endDate.Before(startDate) {
+		return nil, status.Error(codes.InvalidArgument, "end_date is before start_date")
+	}
+
+	days := payment_plan.GetNonBusinessDaysBetween(startDate, endDate)
+	dates := make([]*timestamppb.Timestamp, len(days))
+	for i, day := range days {
+		dates[i] = paymentplanv1.TimeToTimestamp(day)
+	}
+	return &paymentplanv1.GetNonBusinessDaysBetweenResponse{Dates: dates}, nil
+}
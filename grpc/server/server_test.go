@@ -0,0 +1,141 @@
+package server_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/grpc/paymentplanv1"
+	"github.com/ParceladoLara/payment-plan-go-sdk/grpc/server"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func dial(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- server.Serve(ctx, listener) }()
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Error dialing: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Error serving: %v", err)
+		}
+	})
+	return conn
+}
+
+func TestCalculatePaymentPlan(t *testing.T) {
+	client := paymentplanv1.NewPaymentPlanServiceClient(dial(t))
+	params := payment_plantest.NewParams().Build()
+
+	expected, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+
+	response, err := client.CalculatePaymentPlan(context.Background(), &paymentplanv1.CalculatePaymentPlanRequest{Params: paymentplanv1.ParamsToProto(params)})
+	if err != nil {
+		t.Fatalf("Error calling CalculatePaymentPlan: %v", err)
+	}
+	if len(response.Responses) != len(expected) {
+		t.Fatalf("Expected %d responses, got %d", len(expected), len(response.Responses))
+	}
+	// The plans of the fixture, see TestCalculatePaymentPlan of the payment_plan package.
+	amounts := []float64{7996.8, 4049.72, 2734.44, 2077.73}
+	dueDates := []string{"2025-05-05", "2025-06-03", "2025-07-03", "2025-08-04"}
+	for i, r := range response.Responses {
+		got := paymentplanv1.ResponseFromProto(r)
+		payment_plantest.AssertResponseEqual(t, got, expected[i], payment_plantest.Exact)
+		if got.InstallmentAmount != amounts[i] || got.DueDate.In(payment_plantest.Location).Format(time.DateOnly) != dueDates[i] {
+			t.Errorf("Installment %d: Expected %v due on %s, got %v due on %v", i+1, amounts[i], dueDates[i], got.InstallmentAmount, got.DueDate)
+		}
+	}
+
+	params = payment_plantest.NewParams().Installments(0).Build()
+	_, err = client.CalculatePaymentPlan(context.Background(), &paymentplanv1.CalculatePaymentPlanRequest{Params: paymentplanv1.ParamsToProto(params)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument, got %v", err)
+	}
+
+	params = payment_plantest.NewParams().Dates(time.Time{}, payment_plantest.Date(2025, 05, 3)).Build()
+	_, err = client.CalculatePaymentPlan(context.Background(), &paymentplanv1.CalculatePaymentPlanRequest{Params: paymentplanv1.ParamsToProto(params)})
+	if status.Code(err) != codes.InvalidArgument || status.Convert(err).Message() != "params.requested_date is required" {
+		t.Errorf("Expected InvalidArgument without requested date, got %v", err)
+	}
+}
+
+func TestCalculateDownPaymentPlanDates(t *testing.T) {
+	client := paymentplanv1.NewPaymentPlanServiceClient(dial(t))
+
+	// The dates are checked before calculating, so the native library never gets a zero time.
+	params := payment_plantest.NewParams().DownPayment(1000, 3)
+	params.FirstPaymentDate = time.Time{}
+	_, err := client.CalculateDownPaymentPlan(context.Background(), &paymentplanv1.CalculateDownPaymentPlanRequest{Params: paymentplanv1.DownPaymentParamsToProto(params)})
+	if status.Code(err) != codes.InvalidArgument || status.Convert(err).Message() != "params.first_payment_date is required" {
+		t.Errorf("Expected InvalidArgument without first payment date, got %v", err)
+	}
+
+	params = payment_plantest.NewParams().Dates(payment_plantest.Date(2025, 04, 5), time.Time{}).DownPayment(1000, 3)
+	_, err = client.CalculateDownPaymentPlan(context.Background(), &paymentplanv1.CalculateDownPaymentPlanRequest{Params: paymentplanv1.DownPaymentParamsToProto(params)})
+	if status.Code(err) != codes.InvalidArgument || status.Convert(err).Message() != "params.params.first_payment_date is required" {
+		t.Errorf("Expected InvalidArgument without the first payment date of the params, got %v", err)
+	}
+}
+
+func TestBusinessDays(t *testing.T) {
+	client := paymentplanv1.NewPaymentPlanServiceClient(dial(t))
+	baseDate := time.Date(2025, 04, 3, 0, 0, 0, 0, time.FixedZone("-03", -3*60*60))
+
+	next, err := client.NextDisbursementDate(context.Background(), &paymentplanv1.NextDisbursementDateRequest{BaseDate: paymentplanv1.TimeToTimestamp(baseDate)})
+	if err != nil {
+		t.Fatalf("Error calling NextDisbursementDate: %v", err)
+	}
+	if expected := payment_plan.NextDisbursementDate(baseDate); !paymentplanv1.TimestampToTime(next.Date).Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, paymentplanv1.TimestampToTime(next.Date))
+	}
+
+	_, err = client.DisbursementDateRange(context.Background(), &paymentplanv1.DisbursementDateRangeRequest{Days: 5})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument without base date, got %v", err)
+	}
+
+	days, err := client.GetNonBusinessDaysBetween(context.Background(), &paymentplanv1.GetNonBusinessDaysBetweenRequest{
+		StartDate: paymentplanv1.TimeToTimestamp(baseDate),
+		EndDate:   paymentplanv1.TimeToTimestamp(baseDate.AddDate(0, 0, 6)),
+	})
+	if err != nil {
+		t.Fatalf("Error calling GetNonBusinessDaysBetween: %v", err)
+	}
+	if len(days.Dates) != len(payment_plan.GetNonBusinessDaysBetween(baseDate, baseDate.AddDate(0, 0, 6))) {
+		t.Errorf("Expected the non business days of the package, got %v", days.Dates)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	client := healthpb.NewHealthClient(dial(t))
+	response, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatalf("Error checking health: %v", err)
+	}
+	if response.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("Expected SERVING, got %v", response.Status)
+	}
+}
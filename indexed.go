@@ -0,0 +1,250 @@
+package payment_plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"time"
+)
+
+// IndexPoint is the annual rate of an index (e.g. CDI 0.1415 for 14.15% a.a.) in effect from Date on.
+type IndexPoint struct {
+	Date       time.Time
+	AnnualRate float64
+}
+
+// IndexCurve is a sorted list of index rates. The rate in effect at a date is the one of the last point on or before it,
+// and the first point is used for dates before the curve.
+type IndexCurve []IndexPoint
+
+type indexPointJSON struct {
+	Date       string  `json:"date"`
+	AnnualRate float64 `json:"annual_rate"`
+}
+
+// LoadIndexCurve reads a curve from JSON in the following format:
+//
+//	[
+//		{"date": "2025-04-01", "annual_rate": 0.1415},
+//		{"date": "2025-06-01", "annual_rate": 0.1465}
+//	]
+func LoadIndexCurve(r io.Reader) (IndexCurve, error) {
+	var points []indexPointJSON
+	if err := json.NewDecoder(r).Decode(&points); err != nil {
+		return nil, fmt.Errorf("decoding index curve: %w", err)
+	}
+
+	curve := make(IndexCurve, len(points))
+	for i, p := range points {
+		date, err := time.Parse(time.DateOnly, p.Date)
+		if err != nil {
+			return nil, fmt.Errorf("index curve point %d: %w", i, err)
+		}
+		curve[i] = IndexPoint{Date: date, AnnualRate: p.AnnualRate}
+	}
+	sort.Slice(curve, func(i, j int) bool {
+		return curve[i].Date.Before(curve[j].Date)
+	})
+	return curve, curve.Validate()
+}
+
+// LoadIndexCurveFile reads a curve from a JSON file, see LoadIndexCurve for the format.
+func LoadIndexCurveFile(path string) (IndexCurve, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadIndexCurve(f)
+}
+
+// Validate checks that the curve is not empty, is sorted and has no rate lower than -100%.
+func (c IndexCurve) Validate() error {
+	if len(c) == 0 {
+		return fmt.Errorf("index curve is empty: %w", ErrInvalidParams)
+	}
+	for i, p := range c {
+		if p.AnnualRate <= -1 {
+			return fmt.Errorf("index curve rate %v at %s is not valid: %w", p.AnnualRate, p.Date.Format(time.DateOnly), ErrInvalidParams)
+		}
+		if i > 0 && p.Date.Before(c[i-1].Date) {
+			return fmt.Errorf("index curve is not sorted at %s: %w", p.Date.Format(time.DateOnly), ErrInvalidParams)
+		}
+	}
+	return nil
+}
+
+// RateAt returns the annual rate in effect at the given date, or an error if the curve is empty.
+func (c IndexCurve) RateAt(date time.Time) (float64, error) {
+	if len(c) == 0 {
+		return 0, fmt.Errorf("index curve is empty: %w", ErrInvalidParams)
+	}
+	return c.rateAt(date), nil
+}
+
+// rateAt is RateAt for a curve that is not empty.
+func (c IndexCurve) rateAt(date time.Time) float64 {
+	rate := c[0].AnnualRate
+	for _, p := range c {
+		if daysBetween(p.Date, date) < 0 {
+			break
+		}
+		rate = p.AnnualRate
+	}
+	return rate
+}
+
+// IndexedRate is a post-fixed rate: the index of the curve plus an annual spread, compounded over business days in years of 252 days.
+//
+// for example, CDI + 2% a.a.:
+//
+//	IndexedRate{Curve: cdi, Spread: 0.02}
+type IndexedRate struct {
+	Curve  IndexCurve
+	Spread float64
+}
+
+// Validate checks the curve and that the spread is greater than -100%.
+func (r IndexedRate) Validate() error {
+	if r.Spread <= -1 {
+		return fmt.Errorf("spread %v is not valid: %w", r.Spread, ErrInvalidParams)
+	}
+	return r.Curve.Validate()
+}
+
+// Factor returns the accrual factor of the rate between from and to, i.e. how much 1 at from is worth at to.
+// It returns an error if the rate is not valid.
+func (r IndexedRate) Factor(from time.Time, to time.Time) (float64, error) {
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+	return r.factor(from, to, dateSet(GetNonBusinessDaysBetween(from, to))), nil
+}
+
+func (r IndexedRate) factor(from time.Time, to time.Time, nonBusinessDays map[civilDate]bool) float64 {
+	factor := 1.0
+	for date := from.AddDate(0, 0, 1); daysBetween(date, to) >= 0; date = date.AddDate(0, 0, 1) {
+		if nonBusinessDays[toCivilDate(date)] {
+			continue
+		}
+		factor *= math.Pow((1+r.Curve.rateAt(date))*(1+r.Spread), 1.0/252)
+	}
+	return factor
+}
+
+// IndexedResponse is a Response of a post-fixed plan, where the installments are projected with the curve of the IndexedRate.
+//
+// DueDates holds every due date of the plan, and ProjectedFactors the accrual factor of the rate from the disbursement up to each of them.
+type IndexedResponse struct {
+	Response
+	IndexedRate      IndexedRate
+	DueDates         []time.Time
+	ProjectedFactors []float64
+}
+
+// CalculateIndexedPaymentPlan calculates a post-fixed payment plan, where the interest rate is the index of the curve plus the spread.
+//
+// params.InterestRate is ignored: each installment count uses the monthly rate equivalent to the projected accrual up to its last due date,
+// in months of 21 business days like the discount factors of the native library, so the Response of each installment count describes
+// the projected installments.
+func CalculateIndexedPaymentPlan(params Params, rate IndexedRate) ([]IndexedResponse, error) {
+	if err := rate.Validate(); err != nil {
+		return nil, err
+	}
+
+	// The schedule only depends on the dates of params, so a first calculation gives the due dates of every installment count.
+	// Its limits are lifted, since the installment counts they drop at params.InterestRate may be kept at the indexed rates.
+	projectionParams := params
+	projectionParams.MinInstallmentAmount = 0
+	projectionParams.MaxTotalAmount = math.MaxFloat64
+	projection, err := CalculatePaymentPlan(projectionParams)
+	if err != nil {
+		return nil, err
+	}
+	dueDates, err := scheduleOf(projection)
+	if err != nil {
+		return nil, err
+	}
+	if len(dueDates) < int(params.Installments) {
+		return nil, fmt.Errorf("no due date for %d installments, the schedule has %d: %w", params.Installments, len(dueDates), ErrInvalidParams)
+	}
+
+	disbursementDate := projection[0].DisbursementDate
+	nonBusinessDays := dateSet(GetNonBusinessDaysBetween(disbursementDate, dueDates[len(dueDates)-1]))
+
+	factors := make([]float64, len(dueDates))
+	rates := make([]float64, len(dueDates))
+	for i, dueDate := range dueDates {
+		factors[i] = rate.factor(disbursementDate, dueDate, nonBusinessDays)
+		rates[i] = roundTo(math.Pow(factors[i], 21/float64(businessDaysBetween(disbursementDate, dueDate, nonBusinessDays)))-1, 6)
+	}
+
+	response, err := calculatePerInstallment(params, func(installment uint32, p *Params) {
+		p.InterestRate = rates[installment-1]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]IndexedResponse, len(response))
+	for i, r := range response {
+		n := r.Installment
+		result[i] = IndexedResponse{
+			Response:         r,
+			IndexedRate:      rate,
+			DueDates:         dueDates[:n:n],
+			ProjectedFactors: factors[:n:n],
+		}
+	}
+	return result, nil
+}
+
+// ActualInstallments returns the installments corrected by the actual values of the index.
+//
+// Each installment is the projected InstallmentAmount multiplied by the ratio between the actual and the projected accrual
+// up to its due date. The projected curve is still used after the last point of the actual curve.
+func (r IndexedResponse) ActualInstallments(actual IndexCurve) ([]float64, error) {
+	if err := actual.Validate(); err != nil {
+		return nil, err
+	}
+	if len(r.ProjectedFactors) != len(r.DueDates) {
+		return nil, fmt.Errorf("%d projected factors for %d due dates: %w", len(r.ProjectedFactors), len(r.DueDates), ErrInvalidParams)
+	}
+	if len(r.DueDates) == 0 {
+		return nil, nil
+	}
+
+	curve := append(IndexCurve{}, actual...)
+	last := actual[len(actual)-1].Date
+	for _, p := range r.IndexedRate.Curve {
+		if p.Date.After(last) {
+			curve = append(curve, p)
+		}
+	}
+
+	actualRate := IndexedRate{Curve: curve, Spread: r.IndexedRate.Spread}
+	nonBusinessDays := dateSet(GetNonBusinessDaysBetween(r.DisbursementDate, r.DueDates[len(r.DueDates)-1]))
+
+	installments := make([]float64, len(r.DueDates))
+	for i, dueDate := range r.DueDates {
+		factor := actualRate.factor(r.DisbursementDate, dueDate, nonBusinessDays)
+		installments[i] = roundCents(r.InstallmentAmount * factor / r.ProjectedFactors[i])
+	}
+	return installments, nil
+}
+
+// scheduleOf returns the due dates of a plan, since the response of n installments is due on the n-th due date.
+// The responses must be the plans of 1 up to len(response) installments, in order, so the n-th due date is known for every n.
+func scheduleOf(response []Response) ([]time.Time, error) {
+	dueDates := make([]time.Time, len(response))
+	for i, r := range response {
+		if r.Installment != uint32(i+1) {
+			return nil, fmt.Errorf("the plan of %d installments is missing, so its due date is unknown: %w", i+1, ErrCalculationError)
+		}
+		dueDates[i] = r.DueDate
+	}
+	return dueDates, nil
+}
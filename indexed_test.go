@@ -0,0 +1,107 @@
+package payment_plan_test
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+func TestLoadIndexCurve(t *testing.T) {
+	curve, err := payment_plan.LoadIndexCurve(strings.NewReader(`[
+		{"date": "2025-06-01", "annual_rate": 0.1465},
+		{"date": "2025-04-01", "annual_rate": 0.1415}
+	]`))
+	if err != nil {
+		t.Fatalf("Error loading index curve: %v", err)
+	}
+
+	if len(curve) != 2 {
+		t.Fatalf("Expected 2 points, got %d", len(curve))
+	}
+	if rate, _ := curve.RateAt(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)); rate != 0.1415 {
+		t.Errorf("Expected rate 0.1415 before the curve, got %v", rate)
+	}
+	if rate, _ := curve.RateAt(time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)); rate != 0.1415 {
+		t.Errorf("Expected rate 0.1415 at 2025-05-31, got %v", rate)
+	}
+	if rate, _ := curve.RateAt(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)); rate != 0.1465 {
+		t.Errorf("Expected rate 0.1465 at 2025-06-01, got %v", rate)
+	}
+
+	_, err = payment_plan.LoadIndexCurve(strings.NewReader(`[{"date": "01/04/2025", "annual_rate": 0.1415}]`))
+	if err == nil {
+		t.Errorf("Expected error for invalid date")
+	}
+}
+
+func TestIndexCurveEmpty(t *testing.T) {
+	date := time.Date(2025, 5, 3, 0, 0, 0, 0, time.UTC)
+	if _, err := (payment_plan.IndexCurve{}).RateAt(date); !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams for the rate of an empty curve, got %v", err)
+	}
+	if _, err := (payment_plan.IndexedRate{Spread: 0.02}).Factor(date.AddDate(0, -1, 0), date); !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams for the factor of an empty curve, got %v", err)
+	}
+
+	curve := payment_plan.IndexCurve{{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), AnnualRate: 0.1415}}
+	r := payment_plan.IndexedResponse{
+		IndexedRate: payment_plan.IndexedRate{Curve: curve},
+		DueDates:    []time.Time{date},
+	}
+	if _, err := r.ActualInstallments(curve); !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams for due dates without projected factors, got %v", err)
+	}
+}
+
+func TestCalculateIndexedPaymentPlan(t *testing.T) {
+	params := payment_plantest.NewParams().InterestFree().Build()
+
+	projected := payment_plan.IndexCurve{{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), AnnualRate: 0.1415}}
+	rate := payment_plan.IndexedRate{Curve: projected, Spread: 0.02}
+
+	resp, err := payment_plan.CalculateIndexedPaymentPlan(params, rate)
+	if err != nil {
+		t.Fatalf("Error calculating indexed payment plan: %v", err)
+	}
+
+	// CDI of 14.15% a year plus a spread of 2% is 1.276% a month. The installments of the fixture at that rate were computed with
+	// reference.CalculatePaymentPlan, they were not regenerated with the native library.
+	amounts := []float64{7928.81, 3994.33, 2683.05, 2027.91}
+	for i, r := range resp {
+		if r.InterestRate != 0.01276 || r.InstallmentAmount != amounts[i] {
+			t.Errorf("Installment %d: Expected InterestRate 0.01276 and InstallmentAmount %v, got %v and %v", i+1, amounts[i], r.InterestRate, r.InstallmentAmount)
+		}
+		if len(r.DueDates) != i+1 || len(r.ProjectedFactors) != i+1 {
+			t.Fatalf("Installment %d: Expected %d due dates and factors, got %d and %d", i+1, i+1, len(r.DueDates), len(r.ProjectedFactors))
+		}
+
+		same, err := r.ActualInstallments(projected)
+		if err != nil {
+			t.Fatalf("Installment %d: Error calculating actual installments: %v", i+1, err)
+		}
+		for k, amount := range same {
+			if amount != r.InstallmentAmount {
+				t.Errorf("Installment %d: Expected actual installment %d to be %v, got %v", i+1, k+1, r.InstallmentAmount, amount)
+			}
+		}
+
+		higher, err := r.ActualInstallments(payment_plan.IndexCurve{{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), AnnualRate: 0.16}})
+		if err != nil {
+			t.Fatalf("Installment %d: Error calculating actual installments: %v", i+1, err)
+		}
+		for k, amount := range higher {
+			if amount <= r.InstallmentAmount {
+				t.Errorf("Installment %d: Expected actual installment %d greater than %v, got %v", i+1, k+1, r.InstallmentAmount, amount)
+			}
+		}
+		// A CDI of 16% corrects the installments of 4x by the ratio of the factors of the curves up to each due date.
+		if expected := []float64{2030.11, 2032.83, 2035.56, 2038.42}; r.Installment == 4 && !slices.Equal(higher, expected) {
+			t.Errorf("Expected the actual installments of 4x to be %v, got %v", expected, higher)
+		}
+	}
+}
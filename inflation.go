@@ -0,0 +1,206 @@
+package payment_plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+)
+
+// InflationPoint is the inflation of a month (e.g. IPCA 0.0043 for 0.43% in April 2025).
+// Month is the first day of the month, in UTC.
+type InflationPoint struct {
+	Month time.Time
+	Rate  float64
+}
+
+// InflationSeries is a sorted list of monthly inflation rates without gaps.
+type InflationSeries []InflationPoint
+
+type inflationPointJSON struct {
+	Month string  `json:"month"`
+	Rate  float64 `json:"rate"`
+}
+
+const monthOnly = "2006-01"
+
+// LoadInflationSeries reads a series from JSON in the following format:
+//
+//	[
+//		{"month": "2025-03", "rate": 0.0056},
+//		{"month": "2025-04", "rate": 0.0043}
+//	]
+func LoadInflationSeries(r io.Reader) (InflationSeries, error) {
+	var points []inflationPointJSON
+	if err := json.NewDecoder(r).Decode(&points); err != nil {
+		return nil, fmt.Errorf("decoding inflation series: %w", err)
+	}
+
+	series := make(InflationSeries, len(points))
+	for i, p := range points {
+		month, err := time.Parse(monthOnly, p.Month)
+		if err != nil {
+			return nil, fmt.Errorf("inflation series point %d: %w", i, err)
+		}
+		series[i] = InflationPoint{Month: month, Rate: p.Rate}
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].Month.Before(series[j].Month)
+	})
+	return series, series.Validate()
+}
+
+// LoadInflationSeriesFile reads a series from a JSON file, see LoadInflationSeries for the format.
+func LoadInflationSeriesFile(path string) (InflationSeries, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadInflationSeries(f)
+}
+
+// Validate checks that the series is not empty, has one point for each month without gaps and no rate lower than -100%.
+func (s InflationSeries) Validate() error {
+	if len(s) == 0 {
+		return fmt.Errorf("inflation series is empty: %w", ErrInvalidParams)
+	}
+	for i, p := range s {
+		if p.Rate <= -1 {
+			return fmt.Errorf("inflation rate %v at %s is not valid: %w", p.Rate, p.Month.Format(monthOnly), ErrInvalidParams)
+		}
+		if i > 0 && !monthOf(p.Month).Equal(monthOf(s[i-1].Month).AddDate(0, 1, 0)) {
+			return fmt.Errorf("inflation series is not monthly at %s: %w", p.Month.Format(monthOnly), ErrInvalidParams)
+		}
+	}
+	return nil
+}
+
+// Inflation corrects amounts by a monthly inflation series.
+//
+// LagMonths is how many months before the dates the series is read, since the index of a month is only published in the next one.
+// for example, with a lag of 2 the correction from April to July uses the rates of February, March and April.
+type Inflation struct {
+	Series    InflationSeries
+	LagMonths int
+}
+
+// Validate checks the series and that the lag is not negative.
+func (i Inflation) Validate() error {
+	if i.LagMonths < 0 {
+		return fmt.Errorf("inflation lag %d is negative: %w", i.LagMonths, ErrInvalidParams)
+	}
+	return i.Series.Validate()
+}
+
+// Factor returns the accumulated correction factor from the month of base up to the month of reference, not including it.
+// An error is returned if the inflation is not valid or the series doesn't have any of the months needed.
+func (i Inflation) Factor(base time.Time, reference time.Time) (float64, error) {
+	if err := i.Validate(); err != nil {
+		return 0, err
+	}
+	start := monthOf(base).AddDate(0, -i.LagMonths, 0)
+	end := monthOf(reference).AddDate(0, -i.LagMonths, 0)
+	if !end.After(start) {
+		return 1, nil
+	}
+
+	first := monthOf(i.Series[0].Month)
+	last := monthOf(i.Series[len(i.Series)-1].Month)
+	if start.Before(first) || end.AddDate(0, -1, 0).After(last) {
+		return 0, fmt.Errorf("inflation series from %s to %s doesn't cover %s to %s: %w",
+			first.Format(monthOnly), last.Format(monthOnly), start.Format(monthOnly), end.AddDate(0, -1, 0).Format(monthOnly), ErrInvalidParams)
+	}
+
+	factor := 1.0
+	for _, p := range i.Series {
+		month := monthOf(p.Month)
+		if !month.Before(start) && month.Before(end) {
+			factor *= 1 + p.Rate
+		}
+	}
+	return factor, nil
+}
+
+// LastReference returns the last reference date the series can correct amounts to.
+// An error is returned if the inflation is not valid.
+func (i Inflation) LastReference() (time.Time, error) {
+	if err := i.Validate(); err != nil {
+		return time.Time{}, err
+	}
+	return monthOf(i.Series[len(i.Series)-1].Month).AddDate(0, i.LagMonths+1, 0), nil
+}
+
+// InflationIndexedResponse is a Response of a plan where the installments are corrected by inflation from the disbursement date on.
+//
+// InstallmentAmount is the nominal installment at the disbursement date. DueDates holds every due date of the plan.
+type InflationIndexedResponse struct {
+	Response
+	Inflation Inflation
+	DueDates  []time.Time
+}
+
+// CalculateInflationIndexedPaymentPlan works like CalculatePaymentPlan, but each plan can have its installments corrected by the inflation.
+func CalculateInflationIndexedPaymentPlan(params Params, inflation Inflation) ([]InflationIndexedResponse, error) {
+	if err := inflation.Validate(); err != nil {
+		return nil, err
+	}
+
+	response, err := CalculatePaymentPlan(params)
+	if err != nil {
+		return nil, err
+	}
+
+	dueDates, err := scheduleOf(response)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]InflationIndexedResponse, len(response))
+	for i, r := range response {
+		result[i] = InflationIndexedResponse{Response: r, Inflation: inflation, DueDates: dueDates[: i+1 : i+1]}
+	}
+	return result, nil
+}
+
+// CorrectionFactor returns the accumulated correction factor from the disbursement date up to the reference date.
+func (r InflationIndexedResponse) CorrectionFactor(reference time.Time) (float64, error) {
+	return r.Inflation.Factor(r.DisbursementDate, reference)
+}
+
+// CorrectedInstallmentAt returns the installment amount corrected up to the reference date.
+func (r InflationIndexedResponse) CorrectedInstallmentAt(reference time.Time) (float64, error) {
+	factor, err := r.CorrectionFactor(reference)
+	if err != nil {
+		return 0, err
+	}
+	return roundCents(r.InstallmentAmount * factor), nil
+}
+
+// CorrectedInstallments returns every installment corrected up to its due date.
+// Installments due after the LastReference of the series are corrected up to it, so they must be recalculated once the series is updated.
+func (r InflationIndexedResponse) CorrectedInstallments() ([]float64, error) {
+	last, err := r.Inflation.LastReference()
+	if err != nil {
+		return nil, err
+	}
+	installments := make([]float64, len(r.DueDates))
+	for i, dueDate := range r.DueDates {
+		if dueDate.After(last) {
+			dueDate = last
+		}
+		amount, err := r.CorrectedInstallmentAt(dueDate)
+		if err != nil {
+			return nil, err
+		}
+		installments[i] = amount
+	}
+	return installments, nil
+}
+
+// monthOf returns the first day of the month of t, in UTC.
+func monthOf(t time.Time) time.Time {
+	year, month, _ := t.Date()
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,85 @@
+package payment_plan_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+func TestInflationFactor(t *testing.T) {
+	series, err := payment_plan.LoadInflationSeries(strings.NewReader(`[
+		{"month": "2025-02", "rate": 0.0131},
+		{"month": "2025-03", "rate": 0.0056},
+		{"month": "2025-04", "rate": 0.0043}
+	]`))
+	if err != nil {
+		t.Fatalf("Error loading inflation series: %v", err)
+	}
+
+	inflation := payment_plan.Inflation{Series: series, LagMonths: 2}
+
+	base := time.Date(2025, 4, 7, 0, 0, 0, 0, time.FixedZone("-03", -3*60*60))
+	factor, err := inflation.Factor(base, time.Date(2025, 7, 3, 0, 0, 0, 0, time.FixedZone("-03", -3*60*60)))
+	if err != nil {
+		t.Fatalf("Error calculating factor: %v", err)
+	}
+
+	expected := 1.0131 * 1.0056 * 1.0043
+	if math.Abs(factor-expected) > 1e-12 {
+		t.Errorf("Expected factor %v, got %v", expected, factor)
+	}
+
+	_, err = inflation.Factor(base, time.Date(2025, 8, 4, 0, 0, 0, 0, time.FixedZone("-03", -3*60*60)))
+	if !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams for a month outside the series, got %v", err)
+	}
+
+	_, err = payment_plan.LoadInflationSeries(strings.NewReader(`[{"month": "2025-02", "rate": 0.0131}, {"month": "2025-04", "rate": 0.0043}]`))
+	if !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams for a series with gaps, got %v", err)
+	}
+
+	if _, err := (payment_plan.Inflation{}).Factor(base, base.AddDate(0, 1, 0)); !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams for an empty series, got %v", err)
+	}
+	if _, err := (payment_plan.InflationIndexedResponse{}).CorrectedInstallments(); !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams for a response without a series, got %v", err)
+	}
+}
+
+func TestCalculateInflationIndexedPaymentPlan(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+
+	series := payment_plan.InflationSeries{
+		{Month: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), Rate: 0.0056},
+		{Month: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), Rate: 0.0043},
+		{Month: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), Rate: 0.0026},
+	}
+
+	resp, err := payment_plan.CalculateInflationIndexedPaymentPlan(params, payment_plan.Inflation{Series: series, LagMonths: 1})
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+
+	last := resp[len(resp)-1]
+	installments, err := last.CorrectedInstallments()
+	if err != nil {
+		t.Fatalf("Error correcting installments: %v", err)
+	}
+
+	if last.InstallmentAmount != 2077.73 {
+		t.Errorf("Expected the installment of 4x before correction to be 2077.73, got %v", last.InstallmentAmount)
+	}
+	// Due dates 05-05, 06-03, 07-03 and 08-04 read March, April and May with a lag of 1 month, e.g. 2077.73 * 1.0056 for 05-05
+	expected := []float64{2089.37, 2098.35, 2103.81, 2103.81}
+	for i, amount := range installments {
+		if amount != expected[i] {
+			t.Errorf("Installment %d: Expected %v, got %v", i+1, expected[i], amount)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Package jsonfields finds the fields of a JSON object that a value doesn't decode.
+//
+// The records of the package decode themselves with UnmarshalJSON, which json.Decoder.DisallowUnknownFields doesn't reach,
+// so the fields of the JSON are checked against the ones the value encodes once decoded.
+package jsonfields
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Unknown returns an error naming the first field of data, in nested objects too, that isn't a field of v.
+// v must be the value data was decoded into.
+func Unknown(data []byte, v any) error {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var got, known any
+	if err := json.Unmarshal(data, &got); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(encoded, &known); err != nil {
+		return err
+	}
+	return unknownObjectField("", got, known)
+}
+
+func unknownObjectField(path string, got any, known any) error {
+	gotObject, ok := got.(map[string]any)
+	if !ok {
+		return nil
+	}
+	knownObject, _ := known.(map[string]any)
+	for _, name := range slices.Sorted(maps.Keys(gotObject)) {
+		knownValue, ok := knownObject[name]
+		if !ok {
+			return fmt.Errorf("json: unknown field %q", path+name)
+		}
+		if err := unknownObjectField(path+name+".", gotObject[name], knownValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package payment_plan_uniffi
+
+// This file is not generated by uniffi-bindgen-go. It calls the native library like the generated functions,
+// lowering the arguments into pooled buffers and lifting the results in place from the RustBuffer, so a call doesn't
+// allocate a bytes.Buffer, a bytes.Reader and a slice for each field it crosses the FFI with.
+//
+// The records are encoded as the generated FfiConverters encode them. buffer_test.go checks the bytes against them,
+// so a regeneration changing a record fails the tests instead of the calls.
+
+/*
+#include <payment_plan_uniffi.h>
+*/
+import "C"
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+// lowerBufferSize is the initial capacity of the lowering buffers, enough for DownPaymentParams, the largest argument.
+const lowerBufferSize = 256
+
+// bufferPool holds the buffers of lowerPooled. They are only used during a call, the native library copies
+// the bytes to a RustBuffer of its own, so they can be reused by the next call.
+var bufferPool = sync.Pool{
+	New: func() any {
+		buffer := make([]byte, 0, lowerBufferSize)
+		return &buffer
+	},
+}
+
+// CalculatePaymentPlanPooled is CalculatePaymentPlan with the pooled buffers of this file.
+func CalculatePaymentPlanPooled(params Params) ([]Response, *Error) {
+	rbuf, err := rustCallWithError[Error](FfiConverterError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_payment_plan_uniffi_fn_func_calculate_payment_plan(lowerPooled(params, appendParams), _uniffiStatus),
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return liftInPlace(rbuf, (*decoder).responses), nil
+}
+
+// CalculateDownPaymentPlanPooled is CalculateDownPaymentPlan with the pooled buffers of this file.
+func CalculateDownPaymentPlanPooled(params DownPaymentParams) ([]DownPaymentResponse, *Error) {
+	rbuf, err := rustCallWithError[Error](FfiConverterError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_payment_plan_uniffi_fn_func_calculate_down_payment_plan(lowerPooled(params, appendDownPaymentParams), _uniffiStatus),
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return liftInPlace(rbuf, (*decoder).downPaymentResponses), nil
+}
+
+// GetNonBusinessDaysBetweenPooled is GetNonBusinessDaysBetween with the pooled buffers of this file.
+func GetNonBusinessDaysBetweenPooled(startDate time.Time, endDate time.Time) []time.Time {
+	return liftInPlace(rustCall(func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_payment_plan_uniffi_fn_func_get_non_business_days_between(lowerPooled(startDate, appendTimestamp), lowerPooled(endDate, appendTimestamp), _uniffiStatus),
+		}
+	}), (*decoder).timestamps)
+}
+
+// lowerPooled is LowerIntoRustBuffer, appending the value to a pooled buffer.
+func lowerPooled[T any](value T, appendValue func([]byte, T) []byte) C.RustBuffer {
+	buffer := bufferPool.Get().(*[]byte)
+	*buffer = appendValue((*buffer)[:0], value)
+	rbuf := bytesToRustBuffer(*buffer)
+	bufferPool.Put(buffer)
+	return rbuf
+}
+
+// liftInPlace is LiftFromRustBuffer, reading the RustBuffer without copying it. The value must not keep the bytes,
+// the RustBuffer is freed after lifting.
+func liftInPlace[T any](rbuf RustBufferI, read func(*decoder) T) T {
+	defer rbuf.Free()
+	d := decoder{b: unsafe.Slice((*byte)(rbuf.Data()), rbuf.Len())}
+	value := read(&d)
+	if len(d.b) > 0 {
+		panic(fmt.Errorf("Junk remaining in buffer after lifting: %s", string(d.b)))
+	}
+	return value
+}
+
+func appendParams(b []byte, value Params) []byte {
+	b = appendFloat64(b, value.RequestedAmount)
+	b = appendTimestamp(b, value.FirstPaymentDate)
+	b = appendTimestamp(b, value.RequestedDate)
+	b = binary.BigEndian.AppendUint32(b, value.Installments)
+	b = binary.BigEndian.AppendUint16(b, value.DebitServicePercentage)
+	b = appendFloat64(b, value.Mdr)
+	b = appendFloat64(b, value.TacPercentage)
+	b = appendFloat64(b, value.IofOverall)
+	b = appendFloat64(b, value.IofPercentage)
+	b = appendFloat64(b, value.InterestRate)
+	b = appendFloat64(b, value.MinInstallmentAmount)
+	b = appendFloat64(b, value.MaxTotalAmount)
+	return appendBool(b, value.DisbursementOnlyOnBusinessDays)
+}
+
+func appendDownPaymentParams(b []byte, value DownPaymentParams) []byte {
+	b = appendParams(b, value.Params)
+	b = appendFloat64(b, value.RequestedAmount)
+	b = appendFloat64(b, value.MinInstallmentAmount)
+	b = appendTimestamp(b, value.FirstPaymentDate)
+	return binary.BigEndian.AppendUint32(b, value.Installments)
+}
+
+func appendFloat64(b []byte, value float64) []byte {
+	return binary.BigEndian.AppendUint64(b, math.Float64bits(value))
+}
+
+func appendBool(b []byte, value bool) []byte {
+	if value {
+		return append(b, 1)
+	}
+	return append(b, 0)
+}
+
+// appendTimestamp encodes the time as FfiConverterTimestamp.Write does, seconds and nanoseconds from the epoch.
+func appendTimestamp(b []byte, value time.Time) []byte {
+	sec := value.Unix()
+	nsec := uint32(value.Nanosecond())
+	if value.Unix() < 0 {
+		nsec = 1_000_000_000 - nsec
+		sec += 1
+	}
+	b = binary.BigEndian.AppendUint64(b, uint64(sec))
+	return binary.BigEndian.AppendUint32(b, nsec)
+}
+
+// decoder reads the records of a RustBuffer in place. Like the generated readers, it panics on a short read,
+// with io.EOF when no byte of the value is left and io.ErrUnexpectedEOF when some are.
+type decoder struct {
+	b []byte
+}
+
+func (d *decoder) next(size int) []byte {
+	if len(d.b) < size {
+		if len(d.b) == 0 {
+			panic(io.EOF)
+		}
+		panic(io.ErrUnexpectedEOF)
+	}
+	value := d.b[:size]
+	d.b = d.b[size:]
+	return value
+}
+
+func (d *decoder) uint32() uint32 {
+	return binary.BigEndian.Uint32(d.next(4))
+}
+
+func (d *decoder) int64() int64 {
+	return int64(binary.BigEndian.Uint64(d.next(8)))
+}
+
+func (d *decoder) float64() float64 {
+	return math.Float64frombits(binary.BigEndian.Uint64(d.next(8)))
+}
+
+// length reads the length of a sequence, an int32.
+func (d *decoder) length() int {
+	return int(int32(d.uint32()))
+}
+
+// timestamp decodes a time as FfiConverterTimestamp.Read does.
+func (d *decoder) timestamp() time.Time {
+	sec := d.int64()
+	nsec := d.uint32()
+
+	var sign int64 = 1
+	if sec < 0 {
+		sign = -1
+	}
+	return time.Unix(sec, int64(nsec)*sign)
+}
+
+func (d *decoder) timestamps() []time.Time {
+	length := d.length()
+	if length <= 0 {
+		return nil
+	}
+	result := make([]time.Time, length)
+	for i := range result {
+		result[i] = d.timestamp()
+	}
+	return result
+}
+
+func (d *decoder) response() Response {
+	return Response{
+		Installment:                              d.uint32(),
+		DueDate:                                  d.timestamp(),
+		DisbursementDate:                         d.timestamp(),
+		AccumulatedDays:                          d.int64(),
+		DaysIndex:                                d.float64(),
+		AccumulatedDaysIndex:                     d.float64(),
+		InterestRate:                             d.float64(),
+		InstallmentAmount:                        d.float64(),
+		InstallmentAmountWithoutTac:              d.float64(),
+		TotalAmount:                              d.float64(),
+		DebitService:                             d.float64(),
+		CustomerDebitServiceAmount:               d.float64(),
+		CustomerAmount:                           d.float64(),
+		CalculationBasisForEffectiveInterestRate: d.float64(),
+		MerchantDebitServiceAmount:               d.float64(),
+		MerchantTotalAmount:                      d.float64(),
+		SettledToMerchant:                        d.float64(),
+		MdrAmount:                                d.float64(),
+		EffectiveInterestRate:                    d.float64(),
+		TotalEffectiveCost:                       d.float64(),
+		EirYearly:                                d.float64(),
+		TecYearly:                                d.float64(),
+		EirMonthly:                               d.float64(),
+		TecMonthly:                               d.float64(),
+		TotalIof:                                 d.float64(),
+		ContractAmount:                           d.float64(),
+		ContractAmountWithoutTac:                 d.float64(),
+		TacAmount:                                d.float64(),
+		IofPercentage:                            d.float64(),
+		OverallIof:                               d.float64(),
+		PreDisbursementAmount:                    d.float64(),
+		PaidTotalIof:                             d.float64(),
+		PaidContractAmount:                       d.float64(),
+	}
+}
+
+func (d *decoder) responses() []Response {
+	length := d.length()
+	if length <= 0 {
+		return nil
+	}
+	result := make([]Response, length)
+	for i := range result {
+		result[i] = d.response()
+	}
+	return result
+}
+
+func (d *decoder) downPaymentResponses() []DownPaymentResponse {
+	length := d.length()
+	if length <= 0 {
+		return nil
+	}
+	result := make([]DownPaymentResponse, length)
+	for i := range result {
+		result[i] = DownPaymentResponse{
+			InstallmentAmount:   d.float64(),
+			TotalAmount:         d.float64(),
+			InstallmentQuantity: d.uint32(),
+			FirstPaymentDate:    d.timestamp(),
+			Plans:               d.responses(),
+		}
+	}
+	return result
+}
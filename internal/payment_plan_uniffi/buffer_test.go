@@ -0,0 +1,143 @@
+package payment_plan_uniffi
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var location = time.FixedZone("-03", -3*60*60)
+
+var params = Params{
+	RequestedAmount:                7800,
+	FirstPaymentDate:               time.Date(2025, 05, 3, 0, 0, 0, 0, location),
+	RequestedDate:                  time.Date(2025, 04, 5, 0, 0, 0, 0, location),
+	Installments:                   24,
+	DebitServicePercentage:         50,
+	Mdr:                            0.05,
+	IofOverall:                     0.0038,
+	IofPercentage:                  0.000082,
+	InterestRate:                   0.0235,
+	MinInstallmentAmount:           100,
+	MaxTotalAmount:                 1000000,
+	DisbursementOnlyOnBusinessDays: true,
+}
+
+func responses(n int) []Response {
+	response := make([]Response, n)
+	for i := range response {
+		response[i] = Response{
+			Installment:          uint32(i + 1),
+			DueDate:              time.Date(2025, time.Month(5+i), 5, 7, 0, 0, 0, location),
+			DisbursementDate:     time.Date(2025, 04, 7, 7, 0, 0, 0, location),
+			AccumulatedDays:      int64(28 + 30*i),
+			DaysIndex:            0.97,
+			AccumulatedDaysIndex: 0.97 * float64(i+1),
+			InstallmentAmount:    -float64(i) - 0.5,
+			TotalIof:             1.5,
+			ContractAmount:       8000,
+			PaidContractAmount:   7999.99,
+		}
+	}
+	return response
+}
+
+// The pooled encoding must be the one of the generated converters, which the native library decodes.
+func TestAppendParams(t *testing.T) {
+	// A date before the epoch with nanoseconds, which FfiConverterTimestamp encodes from the next second.
+	downPayment := DownPaymentParams{
+		Params:               params,
+		RequestedAmount:      1000,
+		MinInstallmentAmount: 100,
+		FirstPaymentDate:     time.Date(1969, 12, 31, 23, 59, 59, 250, time.UTC),
+		Installments:         4,
+	}
+
+	var expected bytes.Buffer
+	FfiConverterDownPaymentParamsINSTANCE.Write(&expected, downPayment)
+	if encoded := appendDownPaymentParams(nil, downPayment); !bytes.Equal(encoded, expected.Bytes()) {
+		t.Errorf("Expected %x, got %x", expected.Bytes(), encoded)
+	}
+}
+
+func TestReadResponses(t *testing.T) {
+	expected := []DownPaymentResponse{
+		{InstallmentAmount: 1000, TotalAmount: 1000, InstallmentQuantity: 1, FirstPaymentDate: time.Date(2025, 04, 5, 0, 0, 0, 0, location), Plans: responses(24)},
+		{InstallmentAmount: 500, TotalAmount: 1000, InstallmentQuantity: 2, FirstPaymentDate: time.Date(1969, 12, 31, 23, 59, 59, 250, time.UTC)},
+	}
+	var buffer bytes.Buffer
+	FfiConverterSequenceDownPaymentResponseINSTANCE.Write(&buffer, expected)
+
+	// The generated converter is the reference, both lift the timestamps in the local time zone.
+	reference := FfiConverterSequenceDownPaymentResponseINSTANCE.Read(bytes.NewReader(buffer.Bytes()))
+	d := decoder{b: buffer.Bytes()}
+	if response := d.downPaymentResponses(); !reflect.DeepEqual(response, reference) {
+		t.Errorf("Expected %+v, got %+v", reference, response)
+	}
+	if len(d.b) != 0 {
+		t.Errorf("Expected the whole buffer read, %d bytes are left", len(d.b))
+	}
+}
+
+func TestReadShortBuffer(t *testing.T) {
+	var buffer bytes.Buffer
+	FfiConverterSequenceResponseINSTANCE.Write(&buffer, responses(1))
+
+	// A read of no bytes is io.EOF and a read of some of them, like the PaidContractAmount cut here, io.ErrUnexpectedEOF.
+	for _, test := range []struct {
+		length   int
+		expected error
+	}{{0, io.EOF}, {buffer.Len() - 8, io.EOF}, {buffer.Len() - 3, io.ErrUnexpectedEOF}} {
+		func() {
+			defer func() {
+				if err, _ := recover().(error); !errors.Is(err, test.expected) {
+					t.Errorf("Expected a panic with %v reading %d bytes, got %v", test.expected, test.length, err)
+				}
+			}()
+			d := decoder{b: buffer.Bytes()[:test.length]}
+			d.responses()
+		}()
+	}
+}
+
+// The benchmarks compare the pooled encoding with the generated one:
+//
+//	go test -run '^$' -bench . -benchmem ./internal/payment_plan_uniffi
+func BenchmarkWriteParams(b *testing.B) {
+	b.Run("generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			var buffer bytes.Buffer
+			FfiConverterParamsINSTANCE.Write(&buffer, params)
+		}
+	})
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			buffer := bufferPool.Get().(*[]byte)
+			*buffer = appendParams((*buffer)[:0], params)
+			bufferPool.Put(buffer)
+		}
+	})
+}
+
+func BenchmarkReadResponses(b *testing.B) {
+	var buffer bytes.Buffer
+	FfiConverterSequenceResponseINSTANCE.Write(&buffer, responses(24))
+	b.Run("generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			FfiConverterSequenceResponseINSTANCE.Read(bytes.NewReader(buffer.Bytes()))
+		}
+	})
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			d := decoder{b: buffer.Bytes()}
+			d.responses()
+		}
+	})
+}
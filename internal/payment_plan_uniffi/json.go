@@ -0,0 +1,306 @@
+package payment_plan_uniffi
+
+// This file is not generated by uniffi-bindgen-go, it holds the JSON encoding of the records.
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// dateLocation is the location of dates decoded from JSON, the same one used by the native library.
+var dateLocation = time.FixedZone("-03", -3*60*60)
+
+// jsonDate encodes a time.Time as "YYYY-MM-DD", the day of the time in dateLocation. The time of the day is dropped.
+// Decoding accepts "YYYY-MM-DD", as midnight in dateLocation, and RFC 3339 timestamps.
+type jsonDate time.Time
+
+func (d jsonDate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Time(d).In(dateLocation).Format(time.DateOnly))
+}
+
+func (d *jsonDate) UnmarshalJSON(data []byte) error {
+	t, err := unmarshalDate(data, 0)
+	if err != nil {
+		return err
+	}
+	*d = jsonDate(t)
+	return nil
+}
+
+// jsonResponseDate is the jsonDate of the dates of the responses, which decodes "YYYY-MM-DD" at 07:00 in dateLocation,
+// the time of the dates returned by the native library, so the responses it returns are decoded as they were encoded.
+type jsonResponseDate time.Time
+
+func (d jsonResponseDate) MarshalJSON() ([]byte, error) {
+	return jsonDate(d).MarshalJSON()
+}
+
+func (d *jsonResponseDate) UnmarshalJSON(data []byte) error {
+	t, err := unmarshalDate(data, 7)
+	if err != nil {
+		return err
+	}
+	*d = jsonResponseDate(t)
+	return nil
+}
+
+// unmarshalDate decodes "YYYY-MM-DD" at the hour in dateLocation, or an RFC 3339 timestamp.
+func unmarshalDate(data []byte, hour int) (time.Time, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return time.Time{}, err
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, s, dateLocation); err == nil {
+		return t.Add(time.Duration(hour) * time.Hour), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", s)
+	}
+	return t, nil
+}
+
+type paramsJSON struct {
+	RequestedAmount                float64  `json:"requested_amount"`
+	FirstPaymentDate               jsonDate `json:"first_payment_date"`
+	RequestedDate                  jsonDate `json:"requested_date"`
+	Installments                   uint32   `json:"installments"`
+	DebitServicePercentage         uint16   `json:"debit_service_percentage"`
+	Mdr                            float64  `json:"mdr"`
+	TacPercentage                  float64  `json:"tac_percentage"`
+	IofOverall                     float64  `json:"iof_overall"`
+	IofPercentage                  float64  `json:"iof_percentage"`
+	InterestRate                   float64  `json:"interest_rate"`
+	MinInstallmentAmount           float64  `json:"min_installment_amount"`
+	MaxTotalAmount                 float64  `json:"max_total_amount"`
+	DisbursementOnlyOnBusinessDays bool     `json:"disbursement_only_on_business_days"`
+}
+
+func (r Params) MarshalJSON() ([]byte, error) {
+	return json.Marshal(paramsJSON{
+		RequestedAmount:                r.RequestedAmount,
+		FirstPaymentDate:               jsonDate(r.FirstPaymentDate),
+		RequestedDate:                  jsonDate(r.RequestedDate),
+		Installments:                   r.Installments,
+		DebitServicePercentage:         r.DebitServicePercentage,
+		Mdr:                            r.Mdr,
+		TacPercentage:                  r.TacPercentage,
+		IofOverall:                     r.IofOverall,
+		IofPercentage:                  r.IofPercentage,
+		InterestRate:                   r.InterestRate,
+		MinInstallmentAmount:           r.MinInstallmentAmount,
+		MaxTotalAmount:                 r.MaxTotalAmount,
+		DisbursementOnlyOnBusinessDays: r.DisbursementOnlyOnBusinessDays,
+	})
+}
+
+func (r *Params) UnmarshalJSON(data []byte) error {
+	var v paramsJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*r = Params{
+		RequestedAmount:                v.RequestedAmount,
+		FirstPaymentDate:               time.Time(v.FirstPaymentDate),
+		RequestedDate:                  time.Time(v.RequestedDate),
+		Installments:                   v.Installments,
+		DebitServicePercentage:         v.DebitServicePercentage,
+		Mdr:                            v.Mdr,
+		TacPercentage:                  v.TacPercentage,
+		IofOverall:                     v.IofOverall,
+		IofPercentage:                  v.IofPercentage,
+		InterestRate:                   v.InterestRate,
+		MinInstallmentAmount:           v.MinInstallmentAmount,
+		MaxTotalAmount:                 v.MaxTotalAmount,
+		DisbursementOnlyOnBusinessDays: v.DisbursementOnlyOnBusinessDays,
+	}
+	return nil
+}
+
+type downPaymentParamsJSON struct {
+	Params               Params   `json:"params"`
+	RequestedAmount      float64  `json:"requested_amount"`
+	MinInstallmentAmount float64  `json:"min_installment_amount"`
+	FirstPaymentDate     jsonDate `json:"first_payment_date"`
+	Installments         uint32   `json:"installments"`
+}
+
+func (r DownPaymentParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(downPaymentParamsJSON{
+		Params:               r.Params,
+		RequestedAmount:      r.RequestedAmount,
+		MinInstallmentAmount: r.MinInstallmentAmount,
+		FirstPaymentDate:     jsonDate(r.FirstPaymentDate),
+		Installments:         r.Installments,
+	})
+}
+
+func (r *DownPaymentParams) UnmarshalJSON(data []byte) error {
+	var v downPaymentParamsJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*r = DownPaymentParams{
+		Params:               v.Params,
+		RequestedAmount:      v.RequestedAmount,
+		MinInstallmentAmount: v.MinInstallmentAmount,
+		FirstPaymentDate:     time.Time(v.FirstPaymentDate),
+		Installments:         v.Installments,
+	}
+	return nil
+}
+
+type responseJSON struct {
+	Installment                              uint32           `json:"installment"`
+	DueDate                                  jsonResponseDate `json:"due_date"`
+	DisbursementDate                         jsonResponseDate `json:"disbursement_date"`
+	AccumulatedDays                          int64            `json:"accumulated_days"`
+	DaysIndex                                float64          `json:"days_index"`
+	AccumulatedDaysIndex                     float64          `json:"accumulated_days_index"`
+	InterestRate                             float64          `json:"interest_rate"`
+	InstallmentAmount                        float64          `json:"installment_amount"`
+	InstallmentAmountWithoutTac              float64          `json:"installment_amount_without_tac"`
+	TotalAmount                              float64          `json:"total_amount"`
+	DebitService                             float64          `json:"debit_service"`
+	CustomerDebitServiceAmount               float64          `json:"customer_debit_service_amount"`
+	CustomerAmount                           float64          `json:"customer_amount"`
+	CalculationBasisForEffectiveInterestRate float64          `json:"calculation_basis_for_effective_interest_rate"`
+	MerchantDebitServiceAmount               float64          `json:"merchant_debit_service_amount"`
+	MerchantTotalAmount                      float64          `json:"merchant_total_amount"`
+	SettledToMerchant                        float64          `json:"settled_to_merchant"`
+	MdrAmount                                float64          `json:"mdr_amount"`
+	EffectiveInterestRate                    float64          `json:"effective_interest_rate"`
+	TotalEffectiveCost                       float64          `json:"total_effective_cost"`
+	EirYearly                                float64          `json:"eir_yearly"`
+	TecYearly                                float64          `json:"tec_yearly"`
+	EirMonthly                               float64          `json:"eir_monthly"`
+	TecMonthly                               float64          `json:"tec_monthly"`
+	TotalIof                                 float64          `json:"total_iof"`
+	ContractAmount                           float64          `json:"contract_amount"`
+	ContractAmountWithoutTac                 float64          `json:"contract_amount_without_tac"`
+	TacAmount                                float64          `json:"tac_amount"`
+	IofPercentage                            float64          `json:"iof_percentage"`
+	OverallIof                               float64          `json:"overall_iof"`
+	PreDisbursementAmount                    float64          `json:"pre_disbursement_amount"`
+	PaidTotalIof                             float64          `json:"paid_total_iof"`
+	PaidContractAmount                       float64          `json:"paid_contract_amount"`
+}
+
+func (r Response) MarshalJSON() ([]byte, error) {
+	return json.Marshal(responseJSON{
+		Installment:                              r.Installment,
+		DueDate:                                  jsonResponseDate(r.DueDate),
+		DisbursementDate:                         jsonResponseDate(r.DisbursementDate),
+		AccumulatedDays:                          r.AccumulatedDays,
+		DaysIndex:                                r.DaysIndex,
+		AccumulatedDaysIndex:                     r.AccumulatedDaysIndex,
+		InterestRate:                             r.InterestRate,
+		InstallmentAmount:                        r.InstallmentAmount,
+		InstallmentAmountWithoutTac:              r.InstallmentAmountWithoutTac,
+		TotalAmount:                              r.TotalAmount,
+		DebitService:                             r.DebitService,
+		CustomerDebitServiceAmount:               r.CustomerDebitServiceAmount,
+		CustomerAmount:                           r.CustomerAmount,
+		CalculationBasisForEffectiveInterestRate: r.CalculationBasisForEffectiveInterestRate,
+		MerchantDebitServiceAmount:               r.MerchantDebitServiceAmount,
+		MerchantTotalAmount:                      r.MerchantTotalAmount,
+		SettledToMerchant:                        r.SettledToMerchant,
+		MdrAmount:                                r.MdrAmount,
+		EffectiveInterestRate:                    r.EffectiveInterestRate,
+		TotalEffectiveCost:                       r.TotalEffectiveCost,
+		EirYearly:                                r.EirYearly,
+		TecYearly:                                r.TecYearly,
+		EirMonthly:                               r.EirMonthly,
+		TecMonthly:                               r.TecMonthly,
+		TotalIof:                                 r.TotalIof,
+		ContractAmount:                           r.ContractAmount,
+		ContractAmountWithoutTac:                 r.ContractAmountWithoutTac,
+		TacAmount:                                r.TacAmount,
+		IofPercentage:                            r.IofPercentage,
+		OverallIof:                               r.OverallIof,
+		PreDisbursementAmount:                    r.PreDisbursementAmount,
+		PaidTotalIof:                             r.PaidTotalIof,
+		PaidContractAmount:                       r.PaidContractAmount,
+	})
+}
+
+func (r *Response) UnmarshalJSON(data []byte) error {
+	var v responseJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*r = Response{
+		Installment:                              v.Installment,
+		DueDate:                                  time.Time(v.DueDate),
+		DisbursementDate:                         time.Time(v.DisbursementDate),
+		AccumulatedDays:                          v.AccumulatedDays,
+		DaysIndex:                                v.DaysIndex,
+		AccumulatedDaysIndex:                     v.AccumulatedDaysIndex,
+		InterestRate:                             v.InterestRate,
+		InstallmentAmount:                        v.InstallmentAmount,
+		InstallmentAmountWithoutTac:              v.InstallmentAmountWithoutTac,
+		TotalAmount:                              v.TotalAmount,
+		DebitService:                             v.DebitService,
+		CustomerDebitServiceAmount:               v.CustomerDebitServiceAmount,
+		CustomerAmount:                           v.CustomerAmount,
+		CalculationBasisForEffectiveInterestRate: v.CalculationBasisForEffectiveInterestRate,
+		MerchantDebitServiceAmount:               v.MerchantDebitServiceAmount,
+		MerchantTotalAmount:                      v.MerchantTotalAmount,
+		SettledToMerchant:                        v.SettledToMerchant,
+		MdrAmount:                                v.MdrAmount,
+		EffectiveInterestRate:                    v.EffectiveInterestRate,
+		TotalEffectiveCost:                       v.TotalEffectiveCost,
+		EirYearly:                                v.EirYearly,
+		TecYearly:                                v.TecYearly,
+		EirMonthly:                               v.EirMonthly,
+		TecMonthly:                               v.TecMonthly,
+		TotalIof:                                 v.TotalIof,
+		ContractAmount:                           v.ContractAmount,
+		ContractAmountWithoutTac:                 v.ContractAmountWithoutTac,
+		TacAmount:                                v.TacAmount,
+		IofPercentage:                            v.IofPercentage,
+		OverallIof:                               v.OverallIof,
+		PreDisbursementAmount:                    v.PreDisbursementAmount,
+		PaidTotalIof:                             v.PaidTotalIof,
+		PaidContractAmount:                       v.PaidContractAmount,
+	}
+	return nil
+}
+
+type downPaymentResponseJSON struct {
+	InstallmentAmount   float64          `json:"installment_amount"`
+	TotalAmount         float64          `json:"total_amount"`
+	InstallmentQuantity uint32           `json:"installment_quantity"`
+	FirstPaymentDate    jsonResponseDate `json:"first_payment_date"`
+	Plans               []Response       `json:"plans"`
+}
+
+func (r DownPaymentResponse) MarshalJSON() ([]byte, error) {
+	plans := r.Plans
+	if plans == nil {
+		plans = []Response{}
+	}
+	return json.Marshal(downPaymentResponseJSON{
+		InstallmentAmount:   r.InstallmentAmount,
+		TotalAmount:         r.TotalAmount,
+		InstallmentQuantity: r.InstallmentQuantity,
+		FirstPaymentDate:    jsonResponseDate(r.FirstPaymentDate),
+		Plans:               plans,
+	})
+}
+
+func (r *DownPaymentResponse) UnmarshalJSON(data []byte) error {
+	var v downPaymentResponseJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*r = DownPaymentResponse{
+		InstallmentAmount:   v.InstallmentAmount,
+		TotalAmount:         v.TotalAmount,
+		InstallmentQuantity: v.InstallmentQuantity,
+		FirstPaymentDate:    time.Time(v.FirstPaymentDate),
+		Plans:               v.Plans,
+	}
+	return nil
+}
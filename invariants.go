@@ -0,0 +1,142 @@
+package payment_plan
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// centsTolerance is how far amounts of a response may be from each other because of rounding, per installment.
+const centsTolerance = 0.01
+
+// CheckInvariants checks the properties every payment plan calculated from params has, whatever the numbers are:
+//
+//   - every number is finite, and the amounts are not negative
+//   - Installment is between 1 and params.Installments, increasing from one response to the next
+//   - TotalAmount is InstallmentAmount * Installment, within a cent per installment
+//   - ContractAmount is at least params.RequestedAmount, within a cent per installment
+//   - DisbursementDate is not before params.RequestedDate and is the same in every response
+//   - DueDate is after DisbursementDate, and AccumulatedDays is the number of days between them
+//   - DueDate, AccumulatedDays and AccumulatedDaysIndex increase from one response to the next
+//
+// It returns nil or an error wrapping ErrCalculationError with every violation found.
+// The fuzz tests of this package call it, and services can call it on the responses they get before using them.
+func CheckInvariants(params Params, response []Response) error {
+	var errs []error
+	violation := func(i int, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("response %d: %s: %w", i, fmt.Sprintf(format, args...), ErrCalculationError))
+	}
+
+	if len(response) > int(params.Installments) {
+		errs = append(errs, fmt.Errorf("%d responses for %d installments: %w", len(response), params.Installments, ErrCalculationError))
+	}
+
+	for i, r := range response {
+		if field, value := nonFiniteField(r); field != "" {
+			violation(i, "%s is %v", field, value)
+		}
+		for _, amount := range []struct {
+			field string
+			value float64
+		}{
+			{"InstallmentAmount", r.InstallmentAmount},
+			{"TotalAmount", r.TotalAmount},
+			{"ContractAmount", r.ContractAmount},
+			{"TotalIof", r.TotalIof},
+		} {
+			if amount.value < 0 {
+				violation(i, "%s %v is negative", amount.field, amount.value)
+			}
+		}
+
+		if r.Installment < 1 || r.Installment > params.Installments {
+			violation(i, "Installment %d is out of range [1, %d]", r.Installment, params.Installments)
+		}
+		tolerance := centsTolerance * float64(r.Installment)
+		if expected := r.InstallmentAmount * float64(r.Installment); math.Abs(r.TotalAmount-expected) > tolerance {
+			violation(i, "TotalAmount %v is not InstallmentAmount * Installment %v", r.TotalAmount, expected)
+		}
+		if r.ContractAmount < params.RequestedAmount-tolerance {
+			violation(i, "ContractAmount %v is less than RequestedAmount %v", r.ContractAmount, params.RequestedAmount)
+		}
+
+		if daysBetween(params.RequestedDate, r.DisbursementDate) < 0 {
+			violation(i, "DisbursementDate %v is before RequestedDate %v", r.DisbursementDate, params.RequestedDate)
+		}
+		if days := daysBetween(r.DisbursementDate, r.DueDate); days <= 0 {
+			violation(i, "DueDate %v is not after DisbursementDate %v", r.DueDate, r.DisbursementDate)
+		} else if days != r.AccumulatedDays {
+			violation(i, "AccumulatedDays %d is not the %d days from DisbursementDate to DueDate", r.AccumulatedDays, days)
+		}
+
+		if i == 0 {
+			continue
+		}
+		previous := response[i-1]
+		if r.Installment <= previous.Installment {
+			violation(i, "Installment %d is not greater than %d", r.Installment, previous.Installment)
+		}
+		if !r.DisbursementDate.Equal(previous.DisbursementDate) {
+			violation(i, "DisbursementDate %v is not %v", r.DisbursementDate, previous.DisbursementDate)
+		}
+		if !r.DueDate.After(previous.DueDate) {
+			violation(i, "DueDate %v is not after %v", r.DueDate, previous.DueDate)
+		}
+		if r.AccumulatedDays <= previous.AccumulatedDays {
+			violation(i, "AccumulatedDays %d is not greater than %d", r.AccumulatedDays, previous.AccumulatedDays)
+		}
+		if r.AccumulatedDaysIndex <= previous.AccumulatedDaysIndex {
+			violation(i, "AccumulatedDaysIndex %v is not greater than %v", r.AccumulatedDaysIndex, previous.AccumulatedDaysIndex)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CheckDownPaymentInvariants checks the properties every down payment plan calculated from params has:
+//
+//   - InstallmentQuantity is between 1 and params.Installments, increasing from one response to the next
+//   - TotalAmount is params.RequestedAmount and InstallmentAmount * InstallmentQuantity, within a cent per installment
+//   - the Plans of every response hold the invariants of CheckInvariants for params.Params
+//
+// It returns nil or an error wrapping ErrCalculationError with every violation found.
+func CheckDownPaymentInvariants(params DownPaymentParams, response []DownPaymentResponse) error {
+	var errs []error
+	violation := func(i int, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("response %d: %s: %w", i, fmt.Sprintf(format, args...), ErrCalculationError))
+	}
+
+	for i, r := range response {
+		if r.InstallmentQuantity < 1 || r.InstallmentQuantity > params.Installments {
+			violation(i, "InstallmentQuantity %d is out of range [1, %d]", r.InstallmentQuantity, params.Installments)
+		}
+		if i > 0 && r.InstallmentQuantity <= response[i-1].InstallmentQuantity {
+			violation(i, "InstallmentQuantity %d is not greater than %d", r.InstallmentQuantity, response[i-1].InstallmentQuantity)
+		}
+
+		tolerance := centsTolerance * float64(r.InstallmentQuantity)
+		if math.IsNaN(r.TotalAmount) || math.Abs(r.TotalAmount-params.RequestedAmount) > tolerance {
+			violation(i, "TotalAmount %v is not RequestedAmount %v", r.TotalAmount, params.RequestedAmount)
+		}
+		if expected := r.InstallmentAmount * float64(r.InstallmentQuantity); math.IsNaN(expected) || math.Abs(r.TotalAmount-expected) > tolerance {
+			violation(i, "TotalAmount %v is not InstallmentAmount * InstallmentQuantity %v", r.TotalAmount, expected)
+		}
+
+		if err := CheckInvariants(params.Params, r.Plans); err != nil {
+			errs = append(errs, fmt.Errorf("response %d: plans: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// nonFiniteField returns the name and value of the first float64 field of r that is NaN or infinite, or "" if there is none.
+func nonFiniteField(r Response) (string, float64) {
+	v := reflect.ValueOf(r)
+	for i := range v.NumField() {
+		field := v.Field(i)
+		if field.Kind() == reflect.Float64 && (math.IsNaN(field.Float()) || math.IsInf(field.Float(), 0)) {
+			return v.Type().Field(i).Name, field.Float()
+		}
+	}
+	return "", 0
+}
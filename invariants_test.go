@@ -0,0 +1,78 @@
+package payment_plan_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+func TestCheckInvariants(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+	response, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+	if err := payment_plan.CheckInvariants(params, response); err != nil {
+		t.Fatalf("Expected no violations, got %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(r []payment_plan.Response)
+		expected string
+	}{
+		{"NaN", func(r []payment_plan.Response) { r[1].TecYearly = math.NaN() }, "response 1: TecYearly is NaN"},
+		{"negative", func(r []payment_plan.Response) { r[0].TotalIof = -1 }, "response 0: TotalIof -1 is negative"},
+		{"total", func(r []payment_plan.Response) { r[2].TotalAmount += 0.05 }, "response 2: TotalAmount"},
+		{"contract", func(r []payment_plan.Response) { r[3].ContractAmount = 7000 }, "response 3: ContractAmount 7000 is less than RequestedAmount 7800"},
+		{"installment", func(r []payment_plan.Response) { r[3].Installment = 5 }, "response 3: Installment 5 is out of range [1, 4]"},
+		{"order", func(r []payment_plan.Response) { r[1], r[2] = r[2], r[1] }, "response 2: Installment 2 is not greater than 3"},
+		{"due date", func(r []payment_plan.Response) { r[0].DueDate = r[0].DisbursementDate }, "response 0: DueDate"},
+		{"accumulated days", func(r []payment_plan.Response) { r[1].AccumulatedDays++ }, "response 1: AccumulatedDays"},
+		{"disbursement", func(r []payment_plan.Response) { r[2].DisbursementDate = params.RequestedDate.AddDate(0, 0, -1) }, "response 2: DisbursementDate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broken := append([]payment_plan.Response(nil), response...)
+			tt.mutate(broken)
+			err := payment_plan.CheckInvariants(params, broken)
+			if !errors.Is(err, payment_plan.ErrCalculationError) {
+				t.Fatalf("Expected ErrCalculationError, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Expected %q in %q", tt.expected, err)
+			}
+		})
+	}
+
+	if err := payment_plan.CheckInvariants(params, append(response, response[3])); err == nil || !strings.Contains(err.Error(), "5 responses for 4 installments") {
+		t.Errorf("Expected too many responses, got %v", err)
+	}
+}
+
+func TestCheckDownPaymentInvariants(t *testing.T) {
+	params := payment_plantest.NewParams().DownPayment(1000, 4)
+	response, err := payment_plan.CalculateDownPaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating down payment plan: %v", err)
+	}
+	if err := payment_plan.CheckDownPaymentInvariants(params, response); err != nil {
+		t.Fatalf("Expected no violations, got %v", err)
+	}
+
+	response[1].TotalAmount = 900
+	response[2].Plans[0].AccumulatedDays = 0
+	err = payment_plan.CheckDownPaymentInvariants(params, response)
+	if !errors.Is(err, payment_plan.ErrCalculationError) {
+		t.Fatalf("Expected ErrCalculationError, got %v", err)
+	}
+	for _, expected := range []string{"response 1: TotalAmount 900 is not RequestedAmount 1000", "response 2: plans: response 0: AccumulatedDays 0"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected %q in %q", expected, err)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package payment_plan_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+func TestParamsJSON(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		t.Fatalf("Error marshalling params: %v", err)
+	}
+
+	expected := `{"requested_amount":7800,"first_payment_date":"2025-05-03","requested_date":"2025-04-05","installments":4,` +
+		`"debit_service_percentage":0,"mdr":0.05,"tac_percentage":0,"iof_overall":0.0038,"iof_percentage":0.000082,"interest_rate":0.0235,` +
+		`"min_installment_amount":100,"max_total_amount":1000000,"disbursement_only_on_business_days":true}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	var decoded payment_plan.Params
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Error unmarshalling params: %v", err)
+	}
+	if !decoded.FirstPaymentDate.Equal(params.FirstPaymentDate) || !decoded.RequestedDate.Equal(params.RequestedDate) {
+		t.Errorf("Expected dates %v and %v, got %v and %v", params.FirstPaymentDate, params.RequestedDate, decoded.FirstPaymentDate, decoded.RequestedDate)
+	}
+	if again, _ := json.Marshal(decoded); string(again) != string(data) {
+		t.Errorf("Expected %s, got %s", data, again)
+	}
+
+	if err := json.Unmarshal([]byte(`{"first_payment_date":"03/05/2025"}`), &decoded); err == nil {
+		t.Errorf("Expected error for invalid date")
+	}
+}
+
+func TestDownPaymentResponseJSON(t *testing.T) {
+	response := payment_plan.DownPaymentResponse{
+		InstallmentAmount:   1000,
+		TotalAmount:         1000,
+		InstallmentQuantity: 1,
+		FirstPaymentDate:    time.Date(2025, 5, 3, 7, 0, 0, 0, time.FixedZone("-03", -3*60*60)),
+		Plans: []payment_plan.Response{
+			{
+				Installment:                              1,
+				DueDate:                                  time.Date(2025, 6, 3, 7, 0, 0, 0, time.FixedZone("-03", -3*60*60)),
+				DisbursementDate:                         time.Date(2025, 5, 9, 7, 0, 0, 0, time.FixedZone("-03", -3*60*60)),
+				CalculationBasisForEffectiveInterestRate: 7948.93,
+			},
+		},
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		t.Fatalf("Error marshalling response: %v", err)
+	}
+
+	for _, field := range []string{`"installment_quantity":1`, `"first_payment_date":"2025-05-03"`, `"due_date":"2025-06-03"`, `"calculation_basis_for_effective_interest_rate":7948.93`} {
+		if !strings.Contains(string(data), field) {
+			t.Errorf("Expected %s in %s", field, data)
+		}
+	}
+
+	var decoded payment_plan.DownPaymentResponse
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Error unmarshalling response: %v", err)
+	}
+	if len(decoded.Plans) != 1 || decoded.Plans[0].CalculationBasisForEffectiveInterestRate != 7948.93 {
+		t.Errorf("Expected plan to be decoded, got %+v", decoded.Plans)
+	}
+	if !decoded.Plans[0].DueDate.Equal(response.Plans[0].DueDate) || !decoded.FirstPaymentDate.Equal(response.FirstPaymentDate) {
+		t.Errorf("Expected DueDate %v and FirstPaymentDate %v, got %v and %v",
+			response.Plans[0].DueDate, response.FirstPaymentDate, decoded.Plans[0].DueDate, decoded.FirstPaymentDate)
+	}
+
+	// 02:00 UTC is still the previous day in -03.
+	response.Plans[0].DueDate = time.Date(2025, 6, 4, 2, 0, 0, 0, time.UTC)
+	data, err = json.Marshal(response.Plans[0])
+	if err != nil {
+		t.Fatalf("Error marshalling response: %v", err)
+	}
+	if !strings.Contains(string(data), `"due_date":"2025-06-03"`) {
+		t.Errorf("Expected due_date 2025-06-03 in %s", data)
+	}
+}
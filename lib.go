@@ -6,29 +6,43 @@ import (
 	"github.com/ParceladoLara/payment-plan-go-sdk/internal/payment_plan_uniffi"
 )
 
+// Params, Response, DownPaymentParams and DownPaymentResponse are encoded to JSON with snake_case field names
+// (e.g. "calculation_basis_for_effective_interest_rate") and dates as "YYYY-MM-DD", the day in the -03 time zone without the time.
+// Dates of the params are decoded as midnight in the -03 time zone and dates of the responses as 07:00 -03, the time of the dates
+// of the native library. RFC 3339 timestamps are also accepted.
+//
+// The field names are stable for a JSONSchemaVersion: fields may be added, but they are only renamed or removed with a new version.
+const JSONSchemaVersion = 1
+
+// JSONSchemaVersionHeader is the HTTP header with the JSONSchemaVersion of a JSON body, set by payment-plan-server on its responses.
+// The JSON output of the payment-plan command holds it in its "schema_version" field.
+const JSONSchemaVersionHeader = "Payment-Plan-Schema-Version"
+
 type Params = payment_plan_uniffi.Params
 type Response = payment_plan_uniffi.Response
 type DownPaymentParams = payment_plan_uniffi.DownPaymentParams
 type DownPaymentResponse = payment_plan_uniffi.DownPaymentResponse
 
+// ErrInvalidParams and ErrCalculationError can be used with errors.Is to check the errors returned by this package.
+var (
+	ErrInvalidParams    = payment_plan_uniffi.ErrErrorInvalidParams
+	ErrCalculationError = payment_plan_uniffi.ErrErrorCalculationError
+)
+
 func CalculatePaymentPlan(params Params) ([]Response, error) {
-	response, err := payment_plan_uniffi.CalculatePaymentPlan(params)
+	response, err := payment_plan_uniffi.CalculatePaymentPlanPooled(params)
 	if err != nil {
 		return nil, err
 	}
-	convertedResponse := make([]Response, len(response))
-	copy(convertedResponse, response)
-	return convertedResponse, nil
+	return response, nil
 }
 
 func CalculateDownPaymentPlan(params DownPaymentParams) ([]DownPaymentResponse, error) {
-	response, err := payment_plan_uniffi.CalculateDownPaymentPlan(params)
+	response, err := payment_plan_uniffi.CalculateDownPaymentPlanPooled(params)
 	if err != nil {
 		return nil, err
 	}
-	convertedResponse := make([]DownPaymentResponse, len(response))
-	copy(convertedResponse, response)
-	return convertedResponse, nil
+	return response, nil
 }
 
 // NextDisbursementDate calculates the next disbursement date based on the given base date.
@@ -64,5 +78,5 @@ func DisbursementDateRange(baseDate time.Time, days uint32) (time.Time, time.Tim
 //
 // This function assumes disbursement dates on business days only.
 func GetNonBusinessDaysBetween(startDate time.Time, endDate time.Time) []time.Time {
-	return payment_plan_uniffi.GetNonBusinessDaysBetween(startDate, endDate)
+	return payment_plan_uniffi.GetNonBusinessDaysBetweenPooled(startDate, endDate)
 }
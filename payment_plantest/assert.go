@@ -0,0 +1,118 @@
+// Package payment_plantest provides utilities for testing code that uses the payment_plan package:
+// assertions that compare responses field by field within a tolerance, and builders for params fixtures.
+package payment_plantest
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+)
+
+// Tolerance is how far a float64 field may be from its expected value: |got - want| <= max(Absolute, Relative * |want|).
+// Other fields are always compared exactly, and times with time.Time.Equal.
+type Tolerance struct {
+	Absolute float64
+	Relative float64
+	// Fields overrides the tolerance of fields by their Go name, e.g. "DaysIndex".
+	Fields map[string]Tolerance
+}
+
+// Exact is the tolerance of float64 fields that must be equal.
+var Exact = Tolerance{}
+
+// DefaultTolerance ignores the floating point noise of amounts and rates, which the native library rounds to cents and
+// to four or six decimals, and of the days indexes, which it doesn't round.
+var DefaultTolerance = Tolerance{Absolute: 1e-9}
+
+func (tol Tolerance) equal(field string, got float64, want float64) bool {
+	if override, ok := tol.Fields[field]; ok {
+		tol = override
+	}
+	if got == want {
+		return true
+	}
+	return math.Abs(got-want) <= math.Max(tol.Absolute, tol.Relative*math.Abs(want))
+}
+
+// Diff returns a line for each field that differs between got and want, which must be the same type, e.g. []payment_plan.Response.
+// Fields are identified by their path, e.g. "[1].InstallmentAmount" or "[0].Plans[2].DueDate".
+func Diff(got any, want any, tol Tolerance) []string {
+	var diffs []string
+	diff(&diffs, "", "", reflect.ValueOf(got), reflect.ValueOf(want), tol)
+	return diffs
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func diff(diffs *[]string, path string, field string, got reflect.Value, want reflect.Value, tol Tolerance) {
+	if got.Type() != want.Type() {
+		*diffs = append(*diffs, fmt.Sprintf("%sgot a %s, want a %s", label(path), got.Type(), want.Type()))
+		return
+	}
+
+	switch {
+	case got.Type() == timeType:
+		g, w := got.Interface().(time.Time), want.Interface().(time.Time)
+		if !g.Equal(w) {
+			*diffs = append(*diffs, fmt.Sprintf("%sgot %s, want %s", label(path), g.Format(time.RFC3339), w.Format(time.RFC3339)))
+		}
+	case got.Kind() == reflect.Struct:
+		for i := 0; i < got.NumField(); i++ {
+			name := got.Type().Field(i).Name
+			diff(diffs, strings.TrimPrefix(path+"."+name, "."), name, got.Field(i), want.Field(i), tol)
+		}
+	case got.Kind() == reflect.Slice:
+		if got.Len() != want.Len() {
+			*diffs = append(*diffs, fmt.Sprintf("%sgot %d elements, want %d", label(path), got.Len(), want.Len()))
+		}
+		for i := 0; i < min(got.Len(), want.Len()); i++ {
+			diff(diffs, fmt.Sprintf("%s[%d]", path, i), field, got.Index(i), want.Index(i), tol)
+		}
+	case got.Kind() == reflect.Float64:
+		g, w := got.Float(), want.Float()
+		if !tol.equal(field, g, w) {
+			*diffs = append(*diffs, fmt.Sprintf("%sgot %v, want %v (diff %.3g)", label(path), g, w, g-w))
+		}
+	default:
+		if !reflect.DeepEqual(got.Interface(), want.Interface()) {
+			*diffs = append(*diffs, fmt.Sprintf("%sgot %v, want %v", label(path), got.Interface(), want.Interface()))
+		}
+	}
+}
+
+// label prefixes the messages of a path, the values given to Diff have no path.
+func label(path string) string {
+	if path == "" {
+		return ""
+	}
+	return path + ": "
+}
+
+// AssertResponsesEqual reports an error listing the fields that differ between got and want.
+func AssertResponsesEqual(t testing.TB, got []payment_plan.Response, want []payment_plan.Response, tol Tolerance) {
+	t.Helper()
+	if diffs := Diff(got, want, tol); len(diffs) > 0 {
+		t.Errorf("Responses differ:\n\t%s", strings.Join(diffs, "\n\t"))
+	}
+}
+
+// AssertResponseEqual is AssertResponsesEqual for a single response.
+func AssertResponseEqual(t testing.TB, got payment_plan.Response, want payment_plan.Response, tol Tolerance) {
+	t.Helper()
+	if diffs := Diff(got, want, tol); len(diffs) > 0 {
+		t.Errorf("Responses differ:\n\t%s", strings.Join(diffs, "\n\t"))
+	}
+}
+
+// AssertDownPaymentResponsesEqual reports an error listing the fields that differ between got and want, including the ones of their plans.
+func AssertDownPaymentResponsesEqual(t testing.TB, got []payment_plan.DownPaymentResponse, want []payment_plan.DownPaymentResponse, tol Tolerance) {
+	t.Helper()
+	if diffs := Diff(got, want, tol); len(diffs) > 0 {
+		t.Errorf("Down payment responses differ:\n\t%s", strings.Join(diffs, "\n\t"))
+	}
+}
@@ -0,0 +1,101 @@
+package payment_plantest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+// recorder records the errors of an assertion instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestDiff(t *testing.T) {
+	want := []payment_plan.Response{
+		{Installment: 1, DueDate: payment_plantest.Date(2025, 05, 5), InstallmentAmount: 7996.8, DaysIndex: 0.981371965896169},
+		{Installment: 2, DueDate: payment_plantest.Date(2025, 06, 3), InstallmentAmount: 4049.72, DaysIndex: 0.958839243657051},
+	}
+	got := []payment_plan.Response{
+		// The same instant in another time zone
+		{Installment: 1, DueDate: time.Date(2025, 05, 5, 3, 0, 0, 0, time.UTC), InstallmentAmount: 7996.8, DaysIndex: 0.9813719659},
+		{Installment: 2, DueDate: payment_plantest.Date(2025, 06, 4), InstallmentAmount: 4049.73, DaysIndex: 0.958839243657051},
+	}
+
+	diffs := payment_plantest.Diff(got, want, payment_plantest.Exact)
+	expected := []string{
+		"[0].DaysIndex: got 0.9813719659, want 0.981371965896169 (diff 3.83e-12)",
+		"[1].DueDate: got 2025-06-04T00:00:00-03:00, want 2025-06-03T00:00:00-03:00",
+		"[1].InstallmentAmount: got 4049.73, want 4049.72 (diff 0.01)",
+	}
+	if strings.Join(diffs, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expected diffs\n%s\ngot\n%s", strings.Join(expected, "\n"), strings.Join(diffs, "\n"))
+	}
+
+	tol := payment_plantest.Tolerance{Absolute: 0.02, Fields: map[string]payment_plantest.Tolerance{"DaysIndex": {Absolute: 1e-10}}}
+	if diffs := payment_plantest.Diff(got, want, tol); len(diffs) != 1 || !strings.HasPrefix(diffs[0], "[1].DueDate") {
+		t.Errorf("Expected only the due date to differ, got %v", diffs)
+	}
+
+	if diffs := payment_plantest.Diff(got[:1], want, payment_plantest.DefaultTolerance); len(diffs) != 1 || diffs[0] != "got 1 elements, want 2" {
+		t.Errorf("Expected the length to differ, got %v", diffs)
+	}
+}
+
+func TestAssertDownPaymentResponsesEqual(t *testing.T) {
+	want := []payment_plan.DownPaymentResponse{{
+		InstallmentQuantity: 1,
+		TotalAmount:         1000,
+		Plans:               []payment_plan.Response{{Installment: 1, TotalIof: 74.61}},
+	}}
+	got := []payment_plan.DownPaymentResponse{{
+		InstallmentQuantity: 1,
+		TotalAmount:         1000,
+		Plans:               []payment_plan.Response{{Installment: 1, TotalIof: 74.62}},
+	}}
+
+	r := &recorder{TB: t}
+	payment_plantest.AssertDownPaymentResponsesEqual(r, got, want, payment_plantest.DefaultTolerance)
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], "[0].Plans[0].TotalIof: got 74.62, want 74.61") || strings.Contains(r.errors[0], "TotalAmount") {
+		t.Errorf("Expected only TotalIof to be reported, got %v", r.errors)
+	}
+
+	r = &recorder{TB: t}
+	payment_plantest.AssertDownPaymentResponsesEqual(r, want, want, payment_plantest.Exact)
+	if len(r.errors) != 0 {
+		t.Errorf("Expected no errors, got %v", r.errors)
+	}
+}
+
+func TestParamsBuilder(t *testing.T) {
+	base := payment_plantest.NewParams().InterestFree().MerchantPays(100)
+	params := base.Installments(12).Amount(1000).Build()
+	if params.Installments != 12 || params.RequestedAmount != 1000 || params.InterestRate != 0 || params.DebitServicePercentage != 100 {
+		t.Errorf("Expected the builder settings, got %+v", params)
+	}
+	if base.Build().Installments != 4 {
+		t.Errorf("Expected the base builder to be unchanged, got %+v", base.Build())
+	}
+	if err := payment_plan.ValidateParams(params); err != nil {
+		t.Errorf("Expected valid params, got %v", err)
+	}
+
+	downPayment := base.DownPayment(1000, 3)
+	if downPayment.Params.Mdr != 0.05 || downPayment.Installments != 3 || !downPayment.FirstPaymentDate.Equal(payment_plantest.Date(2025, 05, 3)) {
+		t.Errorf("Expected down payment params, got %+v", downPayment)
+	}
+	if err := payment_plan.ValidateDownPaymentParams(downPayment); err != nil {
+		t.Errorf("Expected valid down payment params, got %v", err)
+	}
+}
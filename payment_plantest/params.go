@@ -0,0 +1,133 @@
+package payment_plantest
+
+import (
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+)
+
+// Location is the -03 time zone of the dates of the native library.
+var Location = time.FixedZone("-03", -3*60*60)
+
+// Date returns midnight of the day in Location.
+func Date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, Location)
+}
+
+// ParamsBuilder builds Params fixtures. Its methods return a copy, so a builder can be shared by tests:
+//
+//	base := payment_plantest.NewParams().InterestRate(0.0199)
+//	short := base.Installments(3).Build()
+//	long := base.Installments(24).Build()
+type ParamsBuilder struct {
+	params payment_plan.Params
+}
+
+// NewParams returns a builder of the params used by the examples of the package: R$ 7.800,00 in up to 4 installments
+// requested on 2025-04-05, first payment on 2025-05-03, 2.35% a month of interest, 5% of MDR, IOF of 0.38% plus 0.0082% a day,
+// no TAC, paid by the customer and disbursed on business days.
+func NewParams() ParamsBuilder {
+	return ParamsBuilder{payment_plan.Params{
+		RequestedAmount:                7800,
+		FirstPaymentDate:               Date(2025, 05, 3),
+		RequestedDate:                  Date(2025, 04, 5),
+		Installments:                   4,
+		DebitServicePercentage:         0,
+		Mdr:                            0.05,
+		TacPercentage:                  0,
+		IofOverall:                     0.0038,
+		IofPercentage:                  0.000082,
+		InterestRate:                   0.0235,
+		MinInstallmentAmount:           100,
+		MaxTotalAmount:                 1000000,
+		DisbursementOnlyOnBusinessDays: true,
+	}}
+}
+
+// Build returns the params.
+func (b ParamsBuilder) Build() payment_plan.Params {
+	return b.params
+}
+
+func (b ParamsBuilder) Amount(amount float64) ParamsBuilder {
+	b.params.RequestedAmount = amount
+	return b
+}
+
+func (b ParamsBuilder) Installments(installments uint32) ParamsBuilder {
+	b.params.Installments = installments
+	return b
+}
+
+// Dates sets the requested date and the first payment date.
+func (b ParamsBuilder) Dates(requested time.Time, firstPayment time.Time) ParamsBuilder {
+	b.params.RequestedDate = requested
+	b.params.FirstPaymentDate = firstPayment
+	return b
+}
+
+func (b ParamsBuilder) InterestRate(rate float64) ParamsBuilder {
+	b.params.InterestRate = rate
+	return b
+}
+
+// InterestFree sets no interest, as in plans subsidized by the merchant.
+func (b ParamsBuilder) InterestFree() ParamsBuilder {
+	return b.InterestRate(0)
+}
+
+func (b ParamsBuilder) Mdr(mdr float64) ParamsBuilder {
+	b.params.Mdr = mdr
+	return b
+}
+
+func (b ParamsBuilder) Tac(percentage float64) ParamsBuilder {
+	b.params.TacPercentage = percentage
+	return b
+}
+
+// Iof sets the overall IOF and the daily IOF.
+func (b ParamsBuilder) Iof(overall float64, daily float64) ParamsBuilder {
+	b.params.IofOverall = overall
+	b.params.IofPercentage = daily
+	return b
+}
+
+// NoIof sets no IOF, as in operations exempt from it.
+func (b ParamsBuilder) NoIof() ParamsBuilder {
+	return b.Iof(0, 0)
+}
+
+// MerchantPays sets the percentage of the debit service paid by the merchant, 0 to 100.
+func (b ParamsBuilder) MerchantPays(percentage uint16) ParamsBuilder {
+	b.params.DebitServicePercentage = percentage
+	return b
+}
+
+func (b ParamsBuilder) MinInstallmentAmount(amount float64) ParamsBuilder {
+	b.params.MinInstallmentAmount = amount
+	return b
+}
+
+func (b ParamsBuilder) MaxTotalAmount(amount float64) ParamsBuilder {
+	b.params.MaxTotalAmount = amount
+	return b
+}
+
+// BusinessDaysOnly sets whether the disbursement only happens on business days.
+func (b ParamsBuilder) BusinessDaysOnly(only bool) ParamsBuilder {
+	b.params.DisbursementOnlyOnBusinessDays = only
+	return b
+}
+
+// DownPayment returns down payment params of the amount in up to installments, with these params for the remaining amount.
+// The first down payment is on the first payment date of the params and the minimum down payment installment is R$ 100,00.
+func (b ParamsBuilder) DownPayment(amount float64, installments uint32) payment_plan.DownPaymentParams {
+	return payment_plan.DownPaymentParams{
+		Params:               b.params,
+		RequestedAmount:      amount,
+		MinInstallmentAmount: 100,
+		FirstPaymentDate:     b.params.FirstPaymentDate,
+		Installments:         installments,
+	}
+}
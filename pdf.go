@@ -0,0 +1,152 @@
+package payment_plan
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// pdfLine is a line of text of a pdfDocument.
+type pdfLine struct {
+	text string
+	size float64
+	bold bool
+}
+
+// pdfDocument is a minimal PDF 1.4 writer for text documents in A4, using the standard Helvetica fonts.
+// Text is encoded as WinAnsi, so characters outside of Latin-1 are replaced by "?".
+type pdfDocument struct {
+	lines []pdfLine
+}
+
+const (
+	pdfPageWidth   = 595.0
+	pdfPageHeight  = 842.0
+	pdfMargin      = 50.0
+	pdfLineSpacing = 1.4
+	// pdfMaxChars is about how many Helvetica characters of 10pt fit in a line between the margins.
+	pdfMaxChars = 95
+)
+
+func (d *pdfDocument) add(text string, size float64, bold bool) {
+	for _, line := range wrapText(text, int(pdfMaxChars*10/size)) {
+		d.lines = append(d.lines, pdfLine{text: line, size: size, bold: bold})
+	}
+}
+
+// pages lays out the lines in pages, returning the content stream of each.
+func (d *pdfDocument) pages() []string {
+	var pages []string
+	var content strings.Builder
+	y := pdfPageHeight - pdfMargin
+	for _, line := range d.lines {
+		height := line.size * pdfLineSpacing
+		if y-height < pdfMargin {
+			pages = append(pages, content.String())
+			content.Reset()
+			y = pdfPageHeight - pdfMargin
+		}
+		y -= height
+		if line.text == "" {
+			continue
+		}
+		font := "F1"
+		if line.bold {
+			font = "F2"
+		}
+		fmt.Fprintf(&content, "BT /%s %.1f Tf %.1f %.1f Td (%s) Tj ET\n", font, line.size, pdfMargin, y, pdfEscape(line.text))
+	}
+	return append(pages, content.String())
+}
+
+func (d *pdfDocument) writeTo(w io.Writer) error {
+	pages := d.pages()
+
+	// Objects 1 and 2 are the catalog and the page tree, 3 and 4 the fonts, then a page and its content for each page.
+	var objects []string
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	)
+	for i, content := range pages {
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+				pdfPageWidth, pdfPageHeight, 6+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(content), content),
+		)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// pdfEscape encodes text as a WinAnsi PDF string literal, without the parentheses.
+func pdfEscape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// wrapText breaks text in lines of at most width characters, on spaces when possible.
+func wrapText(text string, width int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return []string{""}
+	}
+	var lines []string
+	line := ""
+	for _, word := range words {
+		for len([]rune(word)) > width {
+			if line != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+			lines = append(lines, string([]rune(word)[:width]))
+			word = string([]rune(word)[width:])
+		}
+		switch {
+		case line == "":
+			line = word
+		case len([]rune(line))+1+len([]rune(word)) > width:
+			lines = append(lines, line)
+			line = word
+		default:
+			line += " " + word
+		}
+	}
+	return append(lines, line)
+}
@@ -0,0 +1,218 @@
+package payment_plan
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Profiles are named products, e.g. "health", "education" and "retail", so a change of the rates or limits of a product
+// is a change of its profile instead of code. They are read by LoadProfiles.
+type Profiles map[string]Product
+
+// productJSON is the encoding of a Product in profiles, with the names of the Params fields in JSON.
+type productJSON struct {
+	DebitServicePercentage         uint16  `json:"debit_service_percentage"`
+	Mdr                            float64 `json:"mdr"`
+	TacPercentage                  float64 `json:"tac_percentage"`
+	IofOverall                     float64 `json:"iof_overall"`
+	IofPercentage                  float64 `json:"iof_percentage"`
+	InterestRate                   float64 `json:"interest_rate"`
+	MinInstallmentAmount           float64 `json:"min_installment_amount"`
+	MaxTotalAmount                 float64 `json:"max_total_amount"`
+	DisbursementOnlyOnBusinessDays bool    `json:"disbursement_only_on_business_days"`
+}
+
+// LoadProfiles reads profiles from JSON or YAML. Each profile has the fields of a Product, named as the Params fields
+// in JSON, and the fields it doesn't have are the ones of DefaultProduct:
+//
+//	{
+//		"health": {"mdr": 0.05, "interest_rate": 0.0199, "min_installment_amount": 100, "max_total_amount": 50000},
+//		"retail": {"mdr": 0.03, "interest_rate": 0.0349, "tac_percentage": 0.02, "max_total_amount": 10000}
+//	}
+//
+// or:
+//
+//	# rates are monthly
+//	health:
+//	  mdr: 0.05
+//	  interest_rate: 0.0199
+//	retail:
+//	  mdr: 0.03
+//	  interest_rate: 0.0349
+//
+// A document starting with "{" is JSON, anything else is YAML. Only the subset of YAML above is supported: comments,
+// and a mapping of profile names to indented mappings of fields to numbers and booleans, without anchors, lists or flow style.
+//
+// Unknown fields are an error, so a misspelled field is not silently replaced by its default. The profiles are validated,
+// see Product.Validate.
+func LoadProfiles(r io.Reader) (Profiles, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading profiles: %w", err)
+	}
+
+	var raw map[string]json.RawMessage
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("decoding profiles: %w", err)
+		}
+	} else if raw, err = yamlProfiles(data); err != nil {
+		return nil, fmt.Errorf("decoding profiles: %w", err)
+	}
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("no profiles: %w", ErrInvalidParams)
+	}
+
+	profiles := make(Profiles, len(raw))
+	for _, name := range slices.Sorted(maps.Keys(raw)) {
+		if name == "" {
+			return nil, fmt.Errorf("profile without a name: %w", ErrInvalidParams)
+		}
+		product, err := decodeProduct(raw[name])
+		if err != nil {
+			return nil, fmt.Errorf("decoding profile %q: %w", name, err)
+		}
+		if err := product.Validate(); err != nil {
+			return nil, fmt.Errorf("profile %q: %w", name, err)
+		}
+		profiles[name] = product
+	}
+	return profiles, nil
+}
+
+// LoadProfilesFile reads profiles from a JSON or YAML file, see LoadProfiles for the format.
+func LoadProfilesFile(path string) (Profiles, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadProfiles(f)
+}
+
+func decodeProduct(message json.RawMessage) (Product, error) {
+	p := productJSON(DefaultProduct)
+	decoder := json.NewDecoder(bytes.NewReader(message))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&p); err != nil {
+		return Product{}, err
+	}
+	return Product(p), nil
+}
+
+// Validate checks the ranges of the fields of the product, the ones ValidateParams checks in its params.
+func (p Product) Validate() error {
+	return ValidateParams(p.Params(1, 1, time.Time{}, time.Time{}))
+}
+
+// Names returns the names of the profiles, sorted.
+func (p Profiles) Names() []string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Product returns the product of the profile, to be used with WithProduct.
+func (p Profiles) Product(name string) (Product, error) {
+	product, ok := p[name]
+	if !ok {
+		return Product{}, fmt.Errorf("unknown profile %q, expected one of %s: %w", name, strings.Join(p.Names(), ", "), ErrInvalidParams)
+	}
+	return product, nil
+}
+
+// Template returns params of the product of the profile, without the requested amount, installments and dates of a plan.
+func (p Profiles) Template(name string) (Params, error) {
+	product, err := p.Product(name)
+	if err != nil {
+		return Params{}, err
+	}
+	return product.Params(0, 0, time.Time{}, time.Time{}), nil
+}
+
+// yamlProfiles reads the subset of YAML of LoadProfiles, returning each profile as a JSON object, so it is decoded
+// like a JSON profile. The numbers and booleans of the subset are written the same way in JSON.
+func yamlProfiles(data []byte) (map[string]json.RawMessage, error) {
+	profiles := map[string]json.RawMessage{}
+	var name string
+	var fields []string
+	seen := map[string]bool{}
+	flush := func() {
+		if name != "" {
+			profiles[name] = json.RawMessage("{" + strings.Join(fields, ",") + "}")
+		}
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := stripYAMLComment(scanner.Text())
+		if strings.TrimSpace(text) == "" || (name == "" && text == "---") {
+			continue
+		}
+		key, value, ok := strings.Cut(strings.TrimSpace(text), ":")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("line %d: expected \"key: value\", got %q", line, text)
+		}
+		key = unquoteYAML(key)
+
+		if indented := text[0] == ' ' || text[0] == '\t'; !indented {
+			if value != "" {
+				return nil, fmt.Errorf("line %d: expected the fields of profile %q on the next lines, got %q", line, key, value)
+			}
+			if _, ok := profiles[key]; ok || key == name {
+				return nil, fmt.Errorf("line %d: duplicate profile %q", line, key)
+			}
+			flush()
+			name, fields = key, nil
+			clear(seen)
+			continue
+		}
+		if name == "" {
+			return nil, fmt.Errorf("line %d: field %q is not in a profile", line, key)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("line %d: duplicate field %q of profile %q", line, key, name)
+		}
+		seen[key] = true
+		if value != "true" && value != "false" && (!json.Valid([]byte(value)) || !strings.ContainsAny(value[:1], "-0123456789")) {
+			return nil, fmt.Errorf("line %d: value %q of %q is not a number or a boolean", line, value, key)
+		}
+		encodedKey, _ := json.Marshal(key)
+		fields = append(fields, string(encodedKey)+":"+value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return profiles, nil
+}
+
+// stripYAMLComment removes a comment, a "#" at the start of the line or after a space, and the trailing spaces.
+func stripYAMLComment(line string) string {
+	for i := range len(line) {
+		if line[i] == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t') {
+			line = line[:i]
+			break
+		}
+	}
+	return strings.TrimRight(line, " \t\r")
+}
+
+func unquoteYAML(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,104 @@
+package payment_plan_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+var expectedProfiles = payment_plan.Profiles{
+	"health": {
+		DebitServicePercentage:         50,
+		Mdr:                            0.05,
+		IofOverall:                     0.0038,
+		IofPercentage:                  0.000082,
+		InterestRate:                   0.0199,
+		MinInstallmentAmount:           100,
+		MaxTotalAmount:                 50000,
+		DisbursementOnlyOnBusinessDays: true,
+	},
+	"education": {
+		Mdr:                            0.04,
+		IofOverall:                     0.0038,
+		IofPercentage:                  0.000082,
+		InterestRate:                   0.0149,
+		MinInstallmentAmount:           150,
+		MaxTotalAmount:                 80000,
+		DisbursementOnlyOnBusinessDays: true,
+	},
+	"retail": {
+		Mdr:                  0.03,
+		TacPercentage:        0.02,
+		IofOverall:           0.0038,
+		IofPercentage:        0.000082,
+		InterestRate:         0.0349,
+		MinInstallmentAmount: 50,
+		MaxTotalAmount:       10000,
+	},
+}
+
+func TestLoadProfilesFile(t *testing.T) {
+	for _, path := range []string{"testdata/profiles/products.yaml", "testdata/profiles/products.json"} {
+		t.Run(path, func(t *testing.T) {
+			profiles, err := payment_plan.LoadProfilesFile(path)
+			if err != nil {
+				t.Fatalf("Error loading profiles: %v", err)
+			}
+			if !reflect.DeepEqual(profiles, expectedProfiles) {
+				t.Errorf("Expected profiles %+v, got %+v", expectedProfiles, profiles)
+			}
+		})
+	}
+}
+
+func TestLoadProfilesErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{"empty", "# no profiles\n", "no profiles"},
+		{"unknown field", "health:\n  interest: 0.0199\n", `unknown field "interest"`},
+		{"unknown json field", `{"health": {"interest": 0.0199}}`, `unknown field "interest"`},
+		{"out of range", "health:\n  mdr: 1.5\n", `profile "health": mdr 1.5 is out of range [0, 1]`},
+		{"string value", "health:\n  mdr: five\n", `line 2: value "five" of "mdr" is not a number or a boolean`},
+		{"null value", "health:\n  mdr: null\n", `line 2: value "null" of "mdr" is not a number or a boolean`},
+		{"duplicate profile", "health:\n  mdr: 0.05\nhealth:\n  mdr: 0.04\n", `line 3: duplicate profile "health"`},
+		{"duplicate field", "health:\n  mdr: 0.05\n  mdr: 0.04\n", `line 3: duplicate field "mdr" of profile "health"`},
+		{"field out of a profile", "  mdr: 0.05\n", `line 1: field "mdr" is not in a profile`},
+		{"inline profile", "health: {mdr: 0.05}\n", `line 1: expected the fields of profile "health" on the next lines`},
+		{"list", "health:\n  - mdr\n", `line 2: expected "key: value"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := payment_plan.LoadProfiles(strings.NewReader(test.source))
+			if err == nil || !strings.Contains(err.Error(), test.expected) {
+				t.Errorf("Expected an error with %q, got %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestProfilesTemplate(t *testing.T) {
+	template, err := expectedProfiles.Template("health")
+	if err != nil {
+		t.Fatalf("Error getting the template: %v", err)
+	}
+	template.RequestedAmount = 7800
+	template.Installments = 4
+	template.RequestedDate = payment_plantest.Date(2025, 04, 5)
+	template.FirstPaymentDate = payment_plantest.Date(2025, 05, 3)
+	expected := payment_plantest.NewParams().Mdr(0.05).InterestRate(0.0199).MerchantPays(50).MaxTotalAmount(50000).Build()
+	if template != expected {
+		t.Errorf("Expected params %+v, got %+v", expected, template)
+	}
+
+	_, err = expectedProfiles.Product("auto")
+	if !errors.Is(err, payment_plan.ErrInvalidParams) || !strings.Contains(err.Error(), "expected one of education, health, retail") {
+		t.Errorf("Expected an unknown profile error naming the profiles, got %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+package payment_plan
+
+import "fmt"
+
+// Rates are the prices applied to a single installment count.
+type Rates struct {
+	InterestRate float64
+	Mdr          float64
+}
+
+// RateTable holds the Rates of ranges of installment counts.
+// Installment counts not present in the table use the InterestRate and Mdr of Params.
+type RateTable struct {
+	ranges []rateRange
+}
+
+// rateRange are the rates of the installment counts from `from` up to `to`, both inclusive.
+type rateRange struct {
+	from  uint32
+	to    uint32
+	rates Rates
+}
+
+// SetRange sets the same rates for every installment count from `from` up to `to`, both inclusive.
+// The rates of a range replace the ones previously set for its installment counts.
+//
+// for example, 1x to 3x at 1.99% and 4x to 12x at 2.35%:
+//
+//	table := RateTable{}
+//	table.SetRange(1, 3, Rates{InterestRate: 0.0199, Mdr: 0.05})
+//	table.SetRange(4, 12, Rates{InterestRate: 0.0235, Mdr: 0.05})
+func (t *RateTable) SetRange(from uint32, to uint32, rates Rates) {
+	if from > to {
+		return
+	}
+	t.ranges = append(t.ranges, rateRange{from: from, to: to, rates: rates})
+}
+
+// RatesFor returns the rates of the given installment count, falling back to the InterestRate and Mdr of params.
+func (t RateTable) RatesFor(installment uint32, params Params) Rates {
+	for i := len(t.ranges) - 1; i >= 0; i-- {
+		if r := t.ranges[i]; installment >= r.from && installment <= r.to {
+			return r.rates
+		}
+	}
+	return Rates{InterestRate: params.InterestRate, Mdr: params.Mdr}
+}
+
+// Validate checks that no rate of the table is negative.
+func (t RateTable) Validate() error {
+	for _, r := range t.ranges {
+		if r.rates.InterestRate < 0 {
+			return fmt.Errorf("interest rate %v for %d to %d installments is negative: %w", r.rates.InterestRate, r.from, r.to, ErrInvalidParams)
+		}
+		if r.rates.Mdr < 0 {
+			return fmt.Errorf("mdr %v for %d to %d installments is negative: %w", r.rates.Mdr, r.from, r.to, ErrInvalidParams)
+		}
+	}
+	return nil
+}
+
+// CalculatePaymentPlanWithRates works like CalculatePaymentPlan, but the InterestRate and Mdr of every
+// installment count are taken from the table instead of params.
+func CalculatePaymentPlanWithRates(params Params, table RateTable) ([]Response, error) {
+	if err := table.Validate(); err != nil {
+		return nil, err
+	}
+	return calculatePerInstallment(params, func(installment uint32, p *Params) {
+		rates := table.RatesFor(installment, params)
+		p.InterestRate = rates.InterestRate
+		p.Mdr = rates.Mdr
+	})
+}
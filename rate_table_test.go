@@ -0,0 +1,83 @@
+package payment_plan_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+func TestCalculatePaymentPlanWithRates(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+
+	table := payment_plan.RateTable{}
+	table.SetRange(1, 2, payment_plan.Rates{InterestRate: 0.0199, Mdr: 0.04})
+
+	resp, err := payment_plan.CalculatePaymentPlanWithRates(params, table)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+
+	plain, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+
+	if len(resp) != len(plain) {
+		t.Fatalf("Expected %d plans, got %d", len(plain), len(resp))
+	}
+
+	// 1x and 2x at 1.99% a month, with 4% of MDR, instead of 7996.8 and 4049.72. The amounts were computed with
+	// reference.CalculatePaymentPlan, they were not regenerated with the native library.
+	expected := []struct {
+		installmentAmount float64
+		eirMonthly        float64
+	}{
+		{7974.03, 0.0175},
+		{4031.15, 0.0186},
+	}
+	for i, r := range resp {
+		if r.Installment <= 2 {
+			if r.InterestRate != 0.0199 {
+				t.Errorf("Installment %d: Expected InterestRate %v, got %v", r.Installment, 0.0199, r.InterestRate)
+			}
+			if r.MdrAmount != 312 || r.SettledToMerchant != 7488 {
+				t.Errorf("Installment %d: Expected MdrAmount 312 and SettledToMerchant 7488, got %v and %v", r.Installment, r.MdrAmount, r.SettledToMerchant)
+			}
+			if e := expected[i]; r.InstallmentAmount != e.installmentAmount || r.EirMonthly != e.eirMonthly {
+				t.Errorf("Installment %d: Expected InstallmentAmount %v and EirMonthly %v, got %v and %v", r.Installment, e.installmentAmount, e.eirMonthly, r.InstallmentAmount, r.EirMonthly)
+			}
+			continue
+		}
+		payment_plantest.AssertResponseEqual(t, r, plain[i], daysIndexTolerance)
+	}
+}
+
+func TestRateTableValidate(t *testing.T) {
+	table := payment_plan.RateTable{}
+	table.SetRange(6, 6, payment_plan.Rates{InterestRate: -0.01})
+
+	if err := table.Validate(); !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams, got %v", err)
+	}
+}
+
+func TestRateTableSetRange(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+	rates := payment_plan.Rates{InterestRate: 0.0199, Mdr: 0.04}
+	table := payment_plan.RateTable{}
+	table.SetRange(13, math.MaxUint32, rates)
+	table.SetRange(3, 2, payment_plan.Rates{InterestRate: 0.01})
+	table.SetRange(24, 24, payment_plan.Rates{InterestRate: 0.0299, Mdr: 0.05})
+
+	plain := payment_plan.Rates{InterestRate: params.InterestRate, Mdr: params.Mdr}
+	for installment, expected := range map[uint32]payment_plan.Rates{
+		2: plain, 3: plain, 12: plain, 13: rates, 23: rates, 24: {InterestRate: 0.0299, Mdr: 0.05}, 25: rates, math.MaxUint32: rates,
+	} {
+		if got := table.RatesFor(installment, params); got != expected {
+			t.Errorf("Installment %d: Expected %+v, got %+v", installment, expected, got)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package reference
+
+import (
+	"slices"
+	"time"
+)
+
+// Location is the time zone of the dates of the responses, which are at 07:00 of the day like the native library's.
+var Location = time.FixedZone("-03", -3*60*60)
+
+const responseHour = 7
+
+// day returns the date of t at 07:00 in Location, the way the responses hold dates.
+func day(t time.Time) time.Time {
+	year, month, d := t.Date()
+	return time.Date(year, month, d, responseHour, 0, 0, 0, Location)
+}
+
+// easter returns Easter Sunday of the year, by the anonymous Gregorian algorithm.
+func easter(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	dayOfMonth := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), dayOfMonth, responseHour, 0, 0, 0, Location)
+}
+
+// Holidays returns the Brazilian national holidays of the year that close the banks, in order: the fixed ones,
+// Carnival Monday and Tuesday, Good Friday and Corpus Christi.
+func Holidays(year int) []time.Time {
+	fixed := func(month time.Month, d int) time.Time {
+		return time.Date(year, month, d, responseHour, 0, 0, 0, Location)
+	}
+	e := easter(year)
+	holidays := []time.Time{
+		fixed(time.January, 1),
+		e.AddDate(0, 0, -48), // Carnival Monday
+		e.AddDate(0, 0, -47), // Carnival Tuesday
+		e.AddDate(0, 0, -2),  // Good Friday
+		fixed(time.April, 21),
+		fixed(time.May, 1),
+		e.AddDate(0, 0, 60), // Corpus Christi
+		fixed(time.September, 7),
+		fixed(time.October, 12),
+		fixed(time.November, 2),
+		fixed(time.November, 15),
+	}
+	if year >= 2024 {
+		holidays = append(holidays, fixed(time.November, 20))
+	}
+	holidays = append(holidays, fixed(time.December, 25))
+	slices.SortFunc(holidays, time.Time.Compare)
+	return holidays
+}
+
+// IsBusinessDay tells if the day of t is neither a weekend nor a holiday.
+func IsBusinessDay(t time.Time) bool {
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return false
+	}
+	t = day(t)
+	for _, holiday := range Holidays(t.Year()) {
+		if holiday.Equal(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// NonBusinessDaysBetween returns the weekends and holidays from start to end, both inclusive, like payment_plan.GetNonBusinessDaysBetween.
+func NonBusinessDaysBetween(start time.Time, end time.Time) []time.Time {
+	var days []time.Time
+	for d := day(start); !d.After(day(end)); d = d.AddDate(0, 0, 1) {
+		if !IsBusinessDay(d) {
+			days = append(days, d)
+		}
+	}
+	return days
+}
+
+// nextBusinessDay returns the day of t if it is a business day, or the first business day after it.
+func nextBusinessDay(t time.Time) time.Time {
+	t = day(t)
+	for !IsBusinessDay(t) {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t
+}
+
+// businessDaysBetween counts the business days after from up to to, inclusive.
+func businessDaysBetween(from time.Time, to time.Time) int {
+	n := 0
+	for d := day(from).AddDate(0, 0, 1); !d.After(day(to)); d = d.AddDate(0, 0, 1) {
+		if IsBusinessDay(d) {
+			n++
+		}
+	}
+	return n
+}
+
+// daysBetween counts the calendar days from `from` to `to`.
+func daysBetween(from time.Time, to time.Time) int64 {
+	a, b := day(from), day(to)
+	return int64(b.Sub(a).Hours()/24 + 0.5)
+}
+
+// addMonths adds n months to t, using the last day of the month when it doesn't have the day of t (e.g. 01-31 + 1 month is 02-28).
+func addMonths(t time.Time, n int) time.Time {
+	year, month, d := t.Date()
+	first := time.Date(year, month+time.Month(n), 1, responseHour, 0, 0, 0, Location)
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+	return first.AddDate(0, 0, d-1)
+}
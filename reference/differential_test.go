@@ -0,0 +1,178 @@
+package reference_test
+
+import (
+	"flag"
+	"fmt"
+	"maps"
+	"math"
+	"math/rand/v2"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+	"github.com/ParceladoLara/payment-plan-go-sdk/reference"
+)
+
+// The differential tests compare the native library with the reference over generated params. They are slow and fail on
+// every divergence of the native library, so they only run with -differential, e.g. after upgrading libpayment_plan_uniffi:
+//
+//	go test ./reference -run Differential -differential -differential.cases 2000
+//
+// The params are generated from -differential.seed, so a run can be repeated with the same params.
+var (
+	differential      = flag.Bool("differential", false, "compare the native library with the reference over generated params")
+	differentialCases = flag.Int("differential.cases", 500, "number of generated params of -differential")
+	differentialSeed  = flag.Uint64("differential.seed", 1, "seed of the generated params of -differential")
+)
+
+func skipUnlessDifferential(t *testing.T) {
+	t.Helper()
+	if !*differential {
+		t.Skip("differential tests run with -differential")
+	}
+}
+
+// generateParams returns the params of the standard fixture varied in every field: the number of installments,
+// amounts, rates, fees and dates from 2024 to 2027.
+func generateParams(n int, seed uint64) []payment_plan.Params {
+	r := rand.New(rand.NewPCG(seed, seed))
+	between := func(low float64, high float64, places int) float64 {
+		scale := math.Pow(10, float64(places))
+		return math.Round((low+r.Float64()*(high-low))*scale) / scale
+	}
+	pick := func(values ...float64) float64 { return values[r.IntN(len(values))] }
+
+	params := make([]payment_plan.Params, n)
+	for i := range params {
+		requested := payment_plantest.Date(2024, 01, 1).AddDate(0, 0, r.IntN(4*365))
+		builder := payment_plantest.NewParams().
+			Amount(between(100, 50000, 2)).
+			Installments(uint32(1+r.IntN(24))).
+			Dates(requested, requested.AddDate(0, 0, 15+r.IntN(46))).
+			InterestRate(between(0, 0.08, 4)).
+			Mdr(pick(0, 0.02, 0.05, 0.1)).
+			Tac(pick(0, 0, 0.02, 0.05)).
+			MerchantPays(uint16(pick(0, 0, 50, 100))).
+			MinInstallmentAmount(pick(0, 50, 100)).
+			BusinessDaysOnly(r.IntN(4) > 0)
+		if r.IntN(5) == 0 {
+			builder = builder.NoIof()
+		}
+		params[i] = builder.Build()
+	}
+	return params
+}
+
+// indexes matches the indexes of the paths of payment_plantest.Diff, e.g. the [1] of "[1].TotalIof".
+var indexes = regexp.MustCompile(`\[\d+\]`)
+
+// divergences groups the divergences by field, e.g. "Plans.TotalIof", with the first params that showed each.
+type divergences struct {
+	total   int
+	count   map[string]int
+	example map[string]string
+}
+
+func (d *divergences) add(params any, diffs []string) {
+	if d.count == nil {
+		d.count = map[string]int{}
+		d.example = map[string]string{}
+	}
+	d.total++
+	seen := map[string]bool{}
+	for _, diff := range diffs {
+		path, _, _ := strings.Cut(diff, ": ")
+		field := strings.TrimPrefix(indexes.ReplaceAllString(path, ""), ".")
+		if seen[field] {
+			continue
+		}
+		seen[field] = true
+		if d.count[field] == 0 {
+			d.example[field] = fmt.Sprintf("%s\n\t\tparams: %+v", diff, params)
+		}
+		d.count[field]++
+	}
+}
+
+// report fails the test with a line for each divergent field, the most frequent first.
+func (d *divergences) report(t *testing.T) {
+	t.Helper()
+	fields := slices.Sorted(maps.Keys(d.count))
+	sort.SliceStable(fields, func(i, j int) bool { return d.count[fields[i]] > d.count[fields[j]] })
+	for _, field := range fields {
+		t.Errorf("%s diverges in %d of %d params, e.g. %s", field, d.count[field], d.total, d.example[field])
+	}
+}
+
+func TestDifferentialPaymentPlan(t *testing.T) {
+	skipUnlessDifferential(t)
+
+	var d divergences
+	for _, params := range generateParams(*differentialCases, *differentialSeed) {
+		native, nativeErr := payment_plan.CalculatePaymentPlan(params)
+		ref, refErr := reference.CalculatePaymentPlan(params)
+		if (nativeErr == nil) != (refErr == nil) {
+			d.add(params, []string{fmt.Sprintf("error: native %v, reference %v", nativeErr, refErr)})
+			continue
+		}
+		d.add(params, payment_plantest.Diff(ref, native, tolerance))
+	}
+	d.report(t)
+}
+
+func TestDifferentialDownPaymentPlan(t *testing.T) {
+	skipUnlessDifferential(t)
+
+	r := rand.New(rand.NewPCG(*differentialSeed, 0))
+	var d divergences
+	for _, base := range generateParams(*differentialCases/10, *differentialSeed) {
+		params := payment_plantest.NewParams().
+			Amount(base.RequestedAmount).
+			Installments(min(base.Installments, 12)).
+			Dates(base.RequestedDate, base.FirstPaymentDate).
+			InterestRate(base.InterestRate).
+			DownPayment(math.Round(base.RequestedAmount*r.Float64()*50)/100, uint32(1+r.IntN(6)))
+
+		native, nativeErr := payment_plan.CalculateDownPaymentPlan(params)
+		ref, refErr := reference.CalculateDownPaymentPlan(params)
+		if (nativeErr == nil) != (refErr == nil) {
+			d.add(params, []string{fmt.Sprintf("error: native %v, reference %v", nativeErr, refErr)})
+			continue
+		}
+		d.add(params, payment_plantest.Diff(ref, native, tolerance))
+	}
+	d.report(t)
+}
+
+func TestDifferentialNonBusinessDays(t *testing.T) {
+	skipUnlessDifferential(t)
+
+	dates := func(days []time.Time) map[string]bool {
+		set := make(map[string]bool, len(days))
+		for _, d := range days {
+			set[d.Format(time.DateOnly)] = true
+		}
+		return set
+	}
+	for year := 2024; year <= 2030; year++ {
+		start := time.Date(year, 01, 1, 0, 0, 0, 0, reference.Location)
+		end := time.Date(year, 12, 31, 0, 0, 0, 0, reference.Location)
+		native := dates(payment_plan.GetNonBusinessDaysBetween(start, end))
+		ref := dates(reference.NonBusinessDaysBetween(start, end))
+		for _, d := range slices.Sorted(maps.Keys(native)) {
+			if !ref[d] {
+				t.Errorf("%s is a non business day of the native library only", d)
+			}
+		}
+		for _, d := range slices.Sorted(maps.Keys(ref)) {
+			if !native[d] {
+				t.Errorf("%s is a non business day of the reference only", d)
+			}
+		}
+	}
+}
@@ -0,0 +1,270 @@
+// Package reference is a readable implementation in Go of the payment plan calculation of the native library,
+// used as an oracle: the differential tests run the same params through both and report the fields where they diverge,
+// so a new version of libpayment_plan_uniffi that changes EirYearly or TotalIof by a few decimals doesn't go unnoticed.
+//
+// The reference is written for clarity, not speed, and it doesn't need the native library, so it runs offline.
+// It models the calculation as the native library documents it through its responses:
+//
+//   - the disbursement is on the requested date, moved to the next business day when DisbursementOnlyOnBusinessDays
+//   - the n-th due date is n-1 months after FirstPaymentDate, moved to the next business day
+//   - the discount factor (DaysIndex) of a due date is (1 + InterestRate)^(-b/21), b being the business days from the disbursement to it
+//   - the installment is the contract amount over the sum of the discount factors (AccumulatedDaysIndex), rounded to cents
+//   - the contract amount is the requested amount plus TAC plus IOF, where IOF is IofOverall of the contract amount plus IofPercentage
+//     a day, up to 365 days, of the principal amortized by each installment in a Price table
+//   - the effective rates are the internal rates of return of the installments, over the contract amount (EIR)
+//     and over the requested amount (TEC), counting calendar days
+//
+// Dates of the responses are at 07:00 in Location, like the native ones, and the business days are the ones of Holidays.
+//
+// The plans payment_plan recalculates itself, e.g. with a grace period or a due date policy, follow the same model in cash_flow.go.
+// The reference is written apart from it, with other algorithms for the same model, so a mistake in one of them shows up as
+// a divergence instead of being copied: the balances of the Price table are the present values of the remaining installments
+// instead of a running balance, the contract amount is the first cent that pays for its own IOF instead of a fixed-point
+// iteration, and the effective rates are found by Newton's method instead of bisection. Agreeing with cash_flow.go
+// doesn't make either one right, the golden files and the differential tests are what check the model against the native library.
+package reference
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+)
+
+// businessDaysInMonth is the number of business days of a month in the discount factors, 252 a year.
+const businessDaysInMonth = 21
+
+// maxIofDays is the maximum number of days of daily IOF of an installment.
+const maxIofDays = 365
+
+// downPaymentSettlementDays is the number of days from the last down payment to the disbursement of the remaining amount.
+const downPaymentSettlementDays = 6
+
+// CalculatePaymentPlan is the reference of payment_plan.CalculatePaymentPlan.
+func CalculatePaymentPlan(params payment_plan.Params) ([]payment_plan.Response, error) {
+	if err := payment_plan.ValidateParams(params); err != nil {
+		return nil, err
+	}
+
+	disbursementDate := day(params.RequestedDate)
+	if params.DisbursementOnlyOnBusinessDays {
+		disbursementDate = nextBusinessDay(disbursementDate)
+	}
+
+	var response []payment_plan.Response
+	var schedule []installment
+	for n := 1; n <= int(params.Installments); n++ {
+		dueDate := nextBusinessDay(addMonths(params.FirstPaymentDate, n-1))
+		if !dueDate.After(disbursementDate) {
+			return nil, fmt.Errorf("due date %s is not after the disbursement date %s: %w",
+				dueDate.Format(time.DateOnly), disbursementDate.Format(time.DateOnly), payment_plan.ErrCalculationError)
+		}
+		schedule = append(schedule, installment{
+			dueDate:        dueDate,
+			days:           daysBetween(disbursementDate, dueDate),
+			discountFactor: discountFactor(params.InterestRate, businessDaysBetween(disbursementDate, dueDate)),
+		})
+
+		r := calculate(params, disbursementDate, schedule)
+		if r.InstallmentAmount < params.MinInstallmentAmount || r.TotalAmount > params.MaxTotalAmount {
+			continue
+		}
+		response = append(response, r)
+	}
+	return response, nil
+}
+
+// CalculateDownPaymentPlan is the reference of payment_plan.CalculateDownPaymentPlan.
+//
+// Each option splits the down payment in equal monthly installments from FirstPaymentDate, and the remaining amount,
+// params.Params.RequestedAmount, is disbursed downPaymentSettlementDays after the last of them, with its first due date a month later.
+func CalculateDownPaymentPlan(params payment_plan.DownPaymentParams) ([]payment_plan.DownPaymentResponse, error) {
+	if err := payment_plan.ValidateDownPaymentParams(params); err != nil {
+		return nil, err
+	}
+
+	var response []payment_plan.DownPaymentResponse
+	for quantity := 1; quantity <= int(params.Installments); quantity++ {
+		amount := params.RequestedAmount / float64(quantity)
+		if amount < params.MinInstallmentAmount {
+			continue
+		}
+
+		remaining := params.Params
+		remaining.RequestedDate = addMonths(params.FirstPaymentDate, quantity-1).AddDate(0, 0, downPaymentSettlementDays)
+		remaining.FirstPaymentDate = addMonths(params.FirstPaymentDate, quantity)
+		plans, err := CalculatePaymentPlan(remaining)
+		if err != nil {
+			return nil, err
+		}
+
+		response = append(response, payment_plan.DownPaymentResponse{
+			InstallmentAmount:   amount,
+			TotalAmount:         params.RequestedAmount,
+			InstallmentQuantity: uint32(quantity),
+			FirstPaymentDate:    day(params.FirstPaymentDate),
+			Plans:               plans,
+		})
+	}
+	return response, nil
+}
+
+// installment is a due date of the schedule, with its calendar days from the disbursement and its discount factor.
+type installment struct {
+	dueDate        time.Time
+	days           int64
+	discountFactor float64
+}
+
+// calculate returns the response of the plan with the installments of the schedule.
+func calculate(params payment_plan.Params, disbursementDate time.Time, schedule []installment) payment_plan.Response {
+	n := len(schedule)
+	last := schedule[n-1]
+	accumulatedDaysIndex := 0.0
+	for _, i := range schedule {
+		accumulatedDaysIndex += i.discountFactor
+	}
+
+	tacAmount := roundTo(params.RequestedAmount*params.TacPercentage, 2)
+	financed := params.RequestedAmount + tacAmount
+	contractAmount, totalIof := solveContractAmount(params, financed, schedule, accumulatedDaysIndex)
+	installmentAmount := roundTo(contractAmount/accumulatedDaysIndex, 2)
+	totalAmount := roundTo(installmentAmount*float64(n), 2)
+
+	r := payment_plan.Response{
+		Installment:          uint32(n),
+		DueDate:              last.dueDate,
+		DisbursementDate:     disbursementDate,
+		AccumulatedDays:      last.days,
+		DaysIndex:            last.discountFactor,
+		AccumulatedDaysIndex: accumulatedDaysIndex,
+		InterestRate:         params.InterestRate,
+		InstallmentAmount:    installmentAmount,
+		TotalAmount:          totalAmount,
+		TotalIof:             totalIof,
+		ContractAmount:       contractAmount,
+		TacAmount:            tacAmount,
+		IofPercentage:        params.IofPercentage,
+		OverallIof:           params.IofOverall,
+	}
+	if tacAmount > 0 {
+		r.ContractAmountWithoutTac = roundTo(contractAmount-tacAmount, 2)
+		r.InstallmentAmountWithoutTac = roundTo(r.ContractAmountWithoutTac/accumulatedDaysIndex, 2)
+	}
+
+	// The debit service is the interest of the plan, split between the customer and the merchant.
+	r.DebitService = totalAmount - contractAmount
+	r.MerchantDebitServiceAmount = r.DebitService * float64(params.DebitServicePercentage) / 100
+	r.CustomerDebitServiceAmount = r.DebitService - r.MerchantDebitServiceAmount
+	r.CustomerAmount = roundTo(installmentAmount-r.MerchantDebitServiceAmount/float64(n), 2)
+	r.CalculationBasisForEffectiveInterestRate = (params.RequestedAmount + r.CustomerDebitServiceAmount) / float64(n)
+
+	r.MdrAmount = roundTo(params.RequestedAmount*params.Mdr, 2)
+	r.MerchantTotalAmount = r.MdrAmount + r.MerchantDebitServiceAmount
+	r.SettledToMerchant = params.RequestedAmount - r.MerchantTotalAmount
+
+	// The contract amount actually paid by the rounded installments.
+	r.PaidContractAmount = roundTo(installmentAmount*accumulatedDaysIndex, 2)
+	r.PaidTotalIof = roundTo(r.PaidContractAmount-financed, 2)
+	r.PreDisbursementAmount = roundTo(r.PaidContractAmount-totalIof, 2)
+
+	days := make([]int64, n)
+	for i, s := range schedule {
+		days[i] = s.days
+	}
+	eirYearly := internalRateOfReturn(contractAmount, installmentAmount, days)
+	tecYearly := internalRateOfReturn(params.RequestedAmount, r.CustomerAmount, days)
+	r.EirYearly = roundTo(eirYearly, 6)
+	r.TecYearly = roundTo(tecYearly, 6)
+	r.EirMonthly = roundTo(math.Pow(1+eirYearly, 1.0/12)-1, 4)
+	r.TecMonthly = roundTo(math.Pow(1+tecYearly, 1.0/12)-1, 4)
+	r.EffectiveInterestRate = r.EirMonthly
+	r.TotalEffectiveCost = r.TecMonthly
+	return r
+}
+
+// discountFactor is (1 + rate)^(-businessDays/21), the present value of 1 paid businessDays after the disbursement.
+func discountFactor(rate float64, businessDays int) float64 {
+	return math.Exp(-float64(businessDays) / businessDaysInMonth * math.Log1p(rate))
+}
+
+// solveContractAmount returns the contract amount, financed plus its IOF, and the IOF.
+//
+// The IOF depends on the contract amount, so the contract amount is the first cent, from financed up, that pays for its own IOF:
+// financed plus the IOF of the contract amount, both rounded to cents, is the contract amount itself. The IOF grows with the
+// contract amount, so that cent is near the contract amount of the unrounded IOF, a linear equation, and the search starts
+// a real below it.
+func solveContractAmount(params payment_plan.Params, financed float64, schedule []installment, accumulatedDaysIndex float64) (float64, float64) {
+	iof := func(contractCents int64) float64 {
+		contractAmount := float64(contractCents) / 100
+		overall := roundTo(contractAmount*params.IofOverall, 2)
+		daily := roundTo(dailyIof(params, contractAmount, schedule, accumulatedDaysIndex), 2)
+		return roundTo(overall+daily, 2)
+	}
+
+	// The IOF of each real of the contract amount, without rounding.
+	iofRate := params.IofOverall + dailyIof(params, 1, schedule, accumulatedDaysIndex)
+	estimate := financed / (1 - iofRate)
+	contractCents := max(toCents(financed), toCents(estimate)-100)
+	for toCents(financed+iof(contractCents)) != contractCents {
+		contractCents++
+	}
+	return float64(contractCents) / 100, iof(contractCents)
+}
+
+// dailyIof is the daily IOF of the principal amortized by each installment of a Price table of the contract amount.
+// The balance after each installment is the present value, at its due date, of the installments left, and the principal
+// an installment amortizes is the balance it leaves less the one before it.
+func dailyIof(params payment_plan.Params, contractAmount float64, schedule []installment, accumulatedDaysIndex float64) float64 {
+	installmentAmount := contractAmount / accumulatedDaysIndex
+	balance := func(paid int) float64 {
+		if paid == 0 {
+			return contractAmount
+		}
+		remaining := 0.0
+		for _, i := range schedule[paid:] {
+			remaining += i.discountFactor
+		}
+		return installmentAmount * remaining / schedule[paid-1].discountFactor
+	}
+
+	iof := 0.0
+	for k, i := range schedule {
+		amortization := balance(k) - balance(k+1)
+		iof += amortization * params.IofPercentage * float64(min(i.days, maxIofDays))
+	}
+	return iof
+}
+
+// internalRateOfReturn returns the yearly rate that discounts the installments, paid the given days after the disbursement,
+// to the present value. It is found by Newton's method from a rate low enough that every step moves up to it: the present
+// value of the installments is convex and decreasing in the rate, so the tangent never crosses the root.
+func internalRateOfReturn(presentValue float64, installmentAmount float64, days []int64) float64 {
+	rate := -0.9
+	for range 1000 {
+		value, derivative := -presentValue, 0.0
+		for _, d := range days {
+			years := float64(d) / 365
+			factor := math.Pow(1+rate, -years)
+			value += installmentAmount * factor
+			derivative -= installmentAmount * years * factor / (1 + rate)
+		}
+		step := value / derivative
+		rate -= step
+		if math.Abs(step) < 1e-15 {
+			break
+		}
+	}
+	return rate
+}
+
+// toCents returns the amount in cents, rounded.
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
@@ -0,0 +1,150 @@
+package reference_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+	"github.com/ParceladoLara/payment-plan-go-sdk/reference"
+)
+
+const goldenDir = "../testdata/golden"
+
+var cents = payment_plantest.Tolerance{Absolute: 0.011}
+
+// sums add up rounded installments, so a cent of difference in the installment is a cent per installment in them.
+var sums = payment_plantest.Tolerance{Absolute: 0.011, Relative: 1e-4}
+
+// paid amounts are the rounded installment times AccumulatedDaysIndex, so a cent in the installment is up to a cent
+// per installment in them, even when they are small, as PaidTotalIof is.
+var paid = payment_plantest.Tolerance{Absolute: 0.25}
+
+// rates are rounded to four decimals by both implementations, so they may differ by one in the last decimal.
+var rates = payment_plantest.Tolerance{Absolute: 1.01e-4}
+
+// tolerance is how far the reference may be from the native library. The contract amount is solved by both up to a cent,
+// so the amounts that depend on it may differ by a cent, the sums of installments by a cent per installment,
+// and the effective rates by what those cents change in them.
+var tolerance = payment_plantest.Tolerance{
+	Absolute: 1e-9,
+	Fields: map[string]payment_plantest.Tolerance{
+		"InstallmentAmount":                        cents,
+		"InstallmentAmountWithoutTac":              cents,
+		"TotalAmount":                              sums,
+		"DebitService":                             sums,
+		"CustomerDebitServiceAmount":               sums,
+		"CustomerAmount":                           cents,
+		"CalculationBasisForEffectiveInterestRate": sums,
+		"MerchantDebitServiceAmount":               sums,
+		"MerchantTotalAmount":                      sums,
+		"SettledToMerchant":                        sums,
+		"TotalIof":                                 cents,
+		"ContractAmount":                           cents,
+		"ContractAmountWithoutTac":                 cents,
+		"PreDisbursementAmount":                    paid,
+		"PaidTotalIof":                             paid,
+		"PaidContractAmount":                       paid,
+		"EffectiveInterestRate":                    rates,
+		"TotalEffectiveCost":                       rates,
+		"EirMonthly":                               rates,
+		"TecMonthly":                               rates,
+		"EirYearly":                                rates,
+		"TecYearly":                                rates,
+	},
+}
+
+func readJSON(t *testing.T, path string, v any) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Error reading %s: %v", path, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatalf("Error decoding %s: %v", path, err)
+	}
+}
+
+// goldenCases returns the names of the cases of a directory of the golden files, each one is a NAME.params.json with a NAME.golden.json.
+func goldenCases(t *testing.T, dir string) []string {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(goldenDir, dir, "*.params.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatalf("No cases in %s", filepath.Join(goldenDir, dir))
+	}
+	names := make([]string, len(paths))
+	for i, path := range paths {
+		names[i] = strings.TrimSuffix(filepath.Base(path), ".params.json")
+	}
+	return names
+}
+
+// readGolden reads the golden file of a case, which is generated from the native library by the golden tests of payment_plan.
+func readGolden(t *testing.T, path string, v any) {
+	t.Helper()
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		t.Fatalf("No golden file %s, generate it with go test -run TestGolden -update in the root package", path)
+	}
+	readJSON(t, path, v)
+}
+
+// The golden files hold responses of the native library, so the reference is checked against it without loading it.
+// Their dates have no time and decode at 07:00 -03, like the dates the native library returns.
+func TestCalculatePaymentPlanGolden(t *testing.T) {
+	for _, name := range goldenCases(t, "plans") {
+		t.Run(name, func(t *testing.T) {
+			var params payment_plan.Params
+			var expected []payment_plan.Response
+			readJSON(t, filepath.Join(goldenDir, "plans", name+".params.json"), &params)
+			readGolden(t, filepath.Join(goldenDir, "plans", name+".golden.json"), &expected)
+
+			response, err := reference.CalculatePaymentPlan(params)
+			if err != nil {
+				t.Fatalf("Error calculating payment plan: %v", err)
+			}
+			payment_plantest.AssertResponsesEqual(t, response, expected, tolerance)
+		})
+	}
+}
+
+func TestCalculateDownPaymentPlanGolden(t *testing.T) {
+	for _, name := range goldenCases(t, "down_payments") {
+		t.Run(name, func(t *testing.T) {
+			var params payment_plan.DownPaymentParams
+			var expected []payment_plan.DownPaymentResponse
+			readJSON(t, filepath.Join(goldenDir, "down_payments", name+".params.json"), &params)
+			readGolden(t, filepath.Join(goldenDir, "down_payments", name+".golden.json"), &expected)
+
+			response, err := reference.CalculateDownPaymentPlan(params)
+			if err != nil {
+				t.Fatalf("Error calculating down payment plan: %v", err)
+			}
+			payment_plantest.AssertDownPaymentResponsesEqual(t, response, expected, tolerance)
+		})
+	}
+}
+
+func TestNonBusinessDaysBetween(t *testing.T) {
+	// The non business days of April 2025 of the native library tests, Good Friday and Tiradentes among them.
+	expected := []int{5, 6, 12, 13, 18, 19, 20, 21, 26, 27}
+	days := reference.NonBusinessDaysBetween(payment_plantest.Date(2025, 04, 1), payment_plantest.Date(2025, 04, 30))
+	if len(days) != len(expected) {
+		t.Fatalf("Expected %d non business days, got %v", len(expected), days)
+	}
+	for i, d := range days {
+		if d.Day() != expected[i] || d.Hour() != 7 {
+			t.Errorf("Expected 2025-04-%02d 07:00, got %v", expected[i], d)
+		}
+	}
+
+	if holidays := reference.Holidays(2025); !holidays[1].Equal(time.Date(2025, 03, 3, 7, 0, 0, 0, reference.Location)) {
+		t.Errorf("Expected Carnival Monday on 2025-03-03, got %v", holidays[1])
+	}
+}
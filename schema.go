@@ -0,0 +1,140 @@
+package payment_plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// JSONSchemaID is the $id of the document returned by JSONSchema.
+const JSONSchemaID = "https://github.com/ParceladoLara/payment-plan-go-sdk/schema/v1.json"
+
+type schemaDefinition struct {
+	name        string
+	typ         reflect.Type
+	rules       []fieldRule
+	description string
+}
+
+var schemaDefinitions = []schemaDefinition{
+	{"Params", reflect.TypeOf(Params{}), paramsRules, "Params of a payment plan calculation."},
+	{"DownPaymentParams", reflect.TypeOf(DownPaymentParams{}), downPaymentParamsRules, "Params of a down payment plan calculation."},
+	{"Response", reflect.TypeOf(Response{}), nil, "A payment plan option for one installment count."},
+	{"DownPaymentResponse", reflect.TypeOf(DownPaymentResponse{}), nil, "A down payment option with the payment plans of the remaining amount."},
+}
+
+// JSONSchema returns a JSON Schema (draft 2020-12) document with the definitions of Params, DownPaymentParams, Response and DownPaymentResponse
+// under "$defs", matching their JSON encoding. The ranges of the request fields are the ones checked by ValidateParams and ValidateDownPaymentParams.
+func JSONSchema() ([]byte, error) {
+	document := map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$id":     JSONSchemaID,
+		"title":   fmt.Sprintf("payment-plan-go-sdk v%d", JSONSchemaVersion),
+		"$defs":   buildSchemas("#/$defs/"),
+	}
+	return marshalSchema(document)
+}
+
+// OpenAPIComponents returns an OpenAPI 3.1 document holding only the components of Params, DownPaymentParams, Response and DownPaymentResponse
+// under "components.schemas", ready to be merged into an API definition.
+func OpenAPIComponents() ([]byte, error) {
+	document := map[string]any{
+		"openapi": "3.1.0",
+		"info": map[string]any{
+			"title":   "payment-plan-go-sdk components",
+			"version": fmt.Sprintf("%d", JSONSchemaVersion),
+		},
+		"components": map[string]any{
+			"schemas": buildSchemas("#/components/schemas/"),
+		},
+	}
+	return marshalSchema(document)
+}
+
+func marshalSchema(document map[string]any) ([]byte, error) {
+	data, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func buildSchemas(refPrefix string) map[string]any {
+	schemas := make(map[string]any, len(schemaDefinitions))
+	for _, def := range schemaDefinitions {
+		properties := map[string]any{}
+		required := []string{}
+		for i := range def.typ.NumField() {
+			field := def.typ.Field(i)
+			name := snakeCase(field.Name)
+			property := fieldSchema(field.Type, refPrefix)
+			for _, rule := range def.rules {
+				if rule.field == name {
+					applyRule(property, rule)
+				}
+			}
+			properties[name] = property
+			required = append(required, name)
+		}
+		schemas[def.name] = map[string]any{
+			"type":        "object",
+			"description": def.description,
+			"properties":  properties,
+			"required":    required,
+		}
+	}
+	return schemas
+}
+
+func fieldSchema(t reflect.Type, refPrefix string) map[string]any {
+	if t == reflect.TypeOf(time.Time{}) {
+		return map[string]any{"type": "string", "format": "date"}
+	}
+	switch t.Kind() {
+	case reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Uint16, reflect.Uint32:
+		return map[string]any{"type": "integer", "minimum": 0, "maximum": uint64(1)<<t.Bits() - 1}
+	case reflect.Int64:
+		return map[string]any{"type": "integer"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Struct:
+		return map[string]any{"$ref": refPrefix + t.Name()}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": fieldSchema(t.Elem(), refPrefix)}
+	default:
+		panic(fmt.Sprintf("no JSON schema for field type %v", t))
+	}
+}
+
+func applyRule(property map[string]any, rule fieldRule) {
+	delete(property, "minimum")
+	if rule.exclusiveMin {
+		property["exclusiveMinimum"] = rule.minimum
+	} else {
+		property["minimum"] = rule.minimum
+	}
+	if !math.IsInf(rule.maximum, 1) {
+		property["maximum"] = rule.maximum
+	}
+}
+
+// snakeCase turns a Go field name into its JSON name, e.g. EirYearly into eir_yearly.
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
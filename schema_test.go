@@ -0,0 +1,127 @@
+package payment_plan_test
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+)
+
+func TestJSONSchema(t *testing.T) {
+	data, err := payment_plan.JSONSchema()
+	if err != nil {
+		t.Fatalf("Error generating JSON Schema: %v", err)
+	}
+
+	var document struct {
+		Defs map[string]struct {
+			Properties map[string]map[string]any `json:"properties"`
+			Required   []string                  `json:"required"`
+		} `json:"$defs"`
+	}
+	if err := json.Unmarshal(data, &document); err != nil {
+		t.Fatalf("Error decoding JSON Schema: %v", err)
+	}
+
+	// The schema properties must be the same as the JSON encoding of each type
+	values := map[string]any{
+		"Params":              payment_plan.Params{},
+		"DownPaymentParams":   payment_plan.DownPaymentParams{},
+		"Response":            payment_plan.Response{},
+		"DownPaymentResponse": payment_plan.DownPaymentResponse{},
+	}
+	for name, value := range values {
+		encoded, _ := json.Marshal(value)
+		var fields map[string]any
+		json.Unmarshal(encoded, &fields)
+
+		def, ok := document.Defs[name]
+		if !ok {
+			t.Fatalf("Expected %s in $defs", name)
+		}
+		for field := range fields {
+			if _, ok := def.Properties[field]; !ok {
+				t.Errorf("%s: Expected property %s in schema", name, field)
+			}
+		}
+		if len(def.Properties) != len(fields) || len(def.Required) != len(fields) {
+			t.Errorf("%s: Expected %d properties and required fields, got %d and %d", name, len(fields), len(def.Properties), len(def.Required))
+		}
+	}
+
+	params := document.Defs["Params"].Properties
+	if params["debit_service_percentage"]["maximum"] != 100.0 {
+		t.Errorf("Expected debit_service_percentage maximum 100, got %v", params["debit_service_percentage"]["maximum"])
+	}
+	if params["requested_amount"]["exclusiveMinimum"] != 0.0 {
+		t.Errorf("Expected requested_amount exclusiveMinimum 0, got %v", params["requested_amount"]["exclusiveMinimum"])
+	}
+	if params["first_payment_date"]["format"] != "date" {
+		t.Errorf("Expected first_payment_date format date, got %v", params["first_payment_date"]["format"])
+	}
+}
+
+func TestOpenAPIComponents(t *testing.T) {
+	data, err := payment_plan.OpenAPIComponents()
+	if err != nil {
+		t.Fatalf("Error generating OpenAPI components: %v", err)
+	}
+
+	var document struct {
+		OpenAPI    string `json:"openapi"`
+		Components struct {
+			Schemas map[string]any `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(data, &document); err != nil {
+		t.Fatalf("Error decoding OpenAPI components: %v", err)
+	}
+
+	if document.OpenAPI != "3.1.0" {
+		t.Errorf("Expected openapi 3.1.0, got %s", document.OpenAPI)
+	}
+	var names []string
+	for name := range document.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	expected := []string{"DownPaymentParams", "DownPaymentResponse", "Params", "Response"}
+	if len(names) != len(expected) {
+		t.Fatalf("Expected schemas %v, got %v", expected, names)
+	}
+	for i := range names {
+		if names[i] != expected[i] {
+			t.Errorf("Expected schemas %v, got %v", expected, names)
+		}
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	params := payment_plan.Params{
+		RequestedAmount:        7800,
+		Installments:           4,
+		DebitServicePercentage: 101,
+		Mdr:                    0.05,
+		IofOverall:             0.0038,
+		IofPercentage:          0.000082,
+		InterestRate:           0.0235,
+		MinInstallmentAmount:   100,
+		MaxTotalAmount:         1000000,
+	}
+
+	if err := payment_plan.ValidateParams(params); !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams, got %v", err)
+	}
+
+	params.DebitServicePercentage = 100
+	if err := payment_plan.ValidateParams(params); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	downPayment := payment_plan.DownPaymentParams{Params: params, RequestedAmount: 0, Installments: 4}
+	if err := payment_plan.ValidateDownPaymentParams(downPayment); !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams, got %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+package payment_plan
+
+import (
+	"fmt"
+	"sort"
+)
+
+// FullSubsidy is the DebitServicePercentage at which the merchant absorbs all of the debit service,
+// which is what an interest-free ("sem juros") plan means for the customer.
+const FullSubsidy uint16 = 100
+
+// Subsidy describes how much of the debit service the merchant absorbs for each installment count.
+// The percentages have the same meaning as Params.DebitServicePercentage: 0 means the customer pays
+// all of the interest and 100 means the merchant pays all of it.
+//
+// for example, to offer 1x to 3x interest-free and let the customer pay the interest from 4x on:
+//
+//	Subsidy{
+//		Default:       0,
+//		ByInstallment: map[uint32]uint16{1: FullSubsidy, 2: FullSubsidy, 3: FullSubsidy},
+//	}
+type Subsidy struct {
+	// Default is used for every installment count not present in ByInstallment.
+	Default       uint16
+	ByInstallment map[uint32]uint16
+}
+
+// InterestFreeUpTo returns a Subsidy where the merchant absorbs all of the interest from 1 up to the given number of installments,
+// and the customer pays all of it for longer plans.
+func InterestFreeUpTo(installments uint32) Subsidy {
+	byInstallment := make(map[uint32]uint16, installments)
+	for i := uint32(1); i <= installments; i++ {
+		byInstallment[i] = FullSubsidy
+	}
+	return Subsidy{ByInstallment: byInstallment}
+}
+
+// PercentageFor returns the DebitServicePercentage the merchant absorbs for the given installment count.
+func (s Subsidy) PercentageFor(installment uint32) uint16 {
+	if percentage, ok := s.ByInstallment[installment]; ok {
+		return percentage
+	}
+	return s.Default
+}
+
+// Validate checks that every percentage of the subsidy is between 0 and 100.
+func (s Subsidy) Validate() error {
+	if s.Default > FullSubsidy {
+		return fmt.Errorf("default subsidy %d%% is greater than 100%%: %w", s.Default, ErrInvalidParams)
+	}
+	for installment, percentage := range s.ByInstallment {
+		if percentage > FullSubsidy {
+			return fmt.Errorf("subsidy %d%% for %d installments is greater than 100%%: %w", percentage, installment, ErrInvalidParams)
+		}
+	}
+	return nil
+}
+
+// CalculateSubsidizedPaymentPlan works like CalculatePaymentPlan, but the DebitServicePercentage of every
+// installment count is taken from the subsidy instead of params.
+//
+// The customer facing amounts (CustomerAmount, CustomerDebitServiceAmount, ...) and the merchant facing amounts
+// (MerchantDebitServiceAmount, MerchantTotalAmount, SettledToMerchant, ...) of each Response reflect the subsidy of its installment count.
+func CalculateSubsidizedPaymentPlan(params Params, subsidy Subsidy) ([]Response, error) {
+	if err := subsidy.Validate(); err != nil {
+		return nil, err
+	}
+	return calculatePerInstallment(params, func(installment uint32, p *Params) {
+		p.DebitServicePercentage = subsidy.PercentageFor(installment)
+	})
+}
+
+// calculatePerInstallment calculates a payment plan where the params can change depending on the installment count.
+// The native library is called once for every distinct set of params, and only the installment counts that belong to it are kept.
+func calculatePerInstallment(params Params, adjust func(installment uint32, p *Params)) ([]Response, error) {
+	type group struct {
+		params       Params
+		installments map[uint32]bool
+	}
+	var groups []*group
+
+	for installment := uint32(1); installment <= params.Installments; installment++ {
+		variant := params
+		adjust(installment, &variant)
+		variant.Installments = installment
+
+		var found *group
+		for _, g := range groups {
+			key := g.params
+			key.Installments = installment
+			if key == variant {
+				found = g
+				break
+			}
+		}
+		if found == nil {
+			found = &group{params: variant, installments: map[uint32]bool{}}
+			groups = append(groups, found)
+		}
+		found.installments[installment] = true
+		found.params.Installments = installment
+	}
+
+	var result []Response
+	for _, g := range groups {
+		response, err := CalculatePaymentPlan(g.params)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range response {
+			if g.installments[r.Installment] {
+				result = append(result, r)
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Installment < result[j].Installment
+	})
+	return result, nil
+}
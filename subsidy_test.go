@@ -0,0 +1,80 @@
+package payment_plan_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+// daysIndexTolerance compares the days indexes to 10 decimal places, like helperAssert, and every other field exactly.
+var daysIndexTolerance = payment_plantest.Tolerance{Fields: map[string]payment_plantest.Tolerance{
+	"DaysIndex":            {Absolute: 1e-10},
+	"AccumulatedDaysIndex": {Absolute: 1e-10},
+}}
+
+func TestCalculateSubsidizedPaymentPlan(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+
+	plain, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+
+	resp, err := payment_plan.CalculateSubsidizedPaymentPlan(params, payment_plan.InterestFreeUpTo(2))
+	if err != nil {
+		t.Fatalf("Error calculating subsidized payment plan: %v", err)
+	}
+
+	if len(resp) != len(plain) {
+		t.Fatalf("Expected %d plans, got %d", len(plain), len(resp))
+	}
+
+	// The merchant pays all of the debit service of 1x and 2x, which the customer doesn't pay in the installments. The amounts are
+	// derived by hand from the native 1x and 2x of TestCalculatePaymentPlan, e.g. 7996.8 - 148.96 and 390 + 148.96 for 1x,
+	// they were not regenerated with the native library.
+	expected := []struct {
+		customerAmount      float64
+		merchantTotalAmount float64
+		settledToMerchant   float64
+	}{
+		{7847.84, 538.96, 7261.04},
+		{3928.66, 632.13, 7167.87},
+	}
+	for i, r := range resp {
+		if r.Installment != uint32(i+1) {
+			t.Errorf("Expected Installment %d, got %d", i+1, r.Installment)
+		}
+		if r.Installment > 2 {
+			payment_plantest.AssertResponseEqual(t, r, plain[i], daysIndexTolerance)
+			continue
+		}
+		e := expected[i]
+		if r.InstallmentAmount != plain[i].InstallmentAmount || r.CustomerDebitServiceAmount != 0 || math.Abs(r.MerchantDebitServiceAmount-plain[i].DebitService) > 1e-9 {
+			t.Errorf("Installment %d: Expected InstallmentAmount %v and MerchantDebitServiceAmount %v, got %v and %v with CustomerDebitServiceAmount %v",
+				r.Installment, plain[i].InstallmentAmount, plain[i].DebitService, r.InstallmentAmount, r.MerchantDebitServiceAmount, r.CustomerDebitServiceAmount)
+		}
+		if r.CustomerAmount != e.customerAmount || math.Abs(r.MerchantTotalAmount-e.merchantTotalAmount) > 1e-9 || math.Abs(r.SettledToMerchant-e.settledToMerchant) > 1e-9 {
+			t.Errorf("Installment %d: Expected CustomerAmount %v, MerchantTotalAmount %v and SettledToMerchant %v, got %v, %v and %v",
+				r.Installment, e.customerAmount, e.merchantTotalAmount, e.settledToMerchant, r.CustomerAmount, r.MerchantTotalAmount, r.SettledToMerchant)
+		}
+	}
+}
+
+func TestSubsidyValidate(t *testing.T) {
+	subsidy := payment_plan.Subsidy{ByInstallment: map[uint32]uint16{3: 101}}
+
+	err := subsidy.Validate()
+	if !errors.Is(err, payment_plan.ErrInvalidParams) {
+		t.Errorf("Expected ErrInvalidParams, got %v", err)
+	}
+
+	if subsidy.PercentageFor(3) != 101 {
+		t.Errorf("Expected PercentageFor(3) 101, got %d", subsidy.PercentageFor(3))
+	}
+	if subsidy.PercentageFor(4) != 0 {
+		t.Errorf("Expected PercentageFor(4) 0, got %d", subsidy.PercentageFor(4))
+	}
+}
@@ -0,0 +1,141 @@
+package payment_plan
+
+import "fmt"
+
+// TraceStep is one intermediate value of a calculation.
+// Installment is the installment count of the plan the step belongs to, or 0 when the step applies to every plan.
+type TraceStep struct {
+	Step        string         `json:"step"`
+	Installment uint32         `json:"installment,omitempty"`
+	Description string         `json:"description"`
+	Values      map[string]any `json:"values"`
+}
+
+// Trace is the ordered list of intermediate values of a calculation.
+type Trace struct {
+	Steps []TraceStep `json:"steps"`
+}
+
+func (t *Trace) add(step string, installment uint32, description string, values map[string]any) {
+	t.Steps = append(t.Steps, TraceStep{Step: step, Installment: installment, Description: description, Values: values})
+}
+
+// CalculatePaymentPlanWithTrace works like CalculatePaymentPlan, but also returns the trace of how each Response was reached:
+// the disbursement date adjustment, the discount factor of each due date, the installment rounding, the IOF iterations
+// and the effective rates.
+//
+// The native library doesn't expose its internals, so the trace is rebuilt from the params and the values it returns.
+// The IOF iterations are the ones of the solver of the plans this package recalculates, e.g. with a grace period, run over the
+// native schedule: the contract amount is iterated from the requested amount until its IOF settles on a cent. The IOF it settles on
+// may differ from the native TotalIof by a cent, the "iof" step of each plan has both.
+func CalculatePaymentPlanWithTrace(params Params) ([]Response, Trace, error) {
+	var trace Trace
+	trace.add("params", 0, "input params", map[string]any{
+		"requested_amount":                   params.RequestedAmount,
+		"first_payment_date":                 params.FirstPaymentDate,
+		"requested_date":                     params.RequestedDate,
+		"installments":                       params.Installments,
+		"debit_service_percentage":           params.DebitServicePercentage,
+		"mdr":                                params.Mdr,
+		"tac_percentage":                     params.TacPercentage,
+		"iof_overall":                        params.IofOverall,
+		"iof_percentage":                     params.IofPercentage,
+		"interest_rate":                      params.InterestRate,
+		"min_installment_amount":             params.MinInstallmentAmount,
+		"max_total_amount":                   params.MaxTotalAmount,
+		"disbursement_only_on_business_days": params.DisbursementOnlyOnBusinessDays,
+	})
+
+	response, err := CalculatePaymentPlan(params)
+	if err != nil {
+		trace.add("error", 0, "the native library rejected the params", map[string]any{"error": err.Error()})
+		return nil, trace, err
+	}
+	if len(response) == 0 {
+		return response, trace, nil
+	}
+
+	schedule, err := nativeSchedule(response)
+	if err != nil {
+		trace.add("error", 0, "the native library returned an incomplete schedule", map[string]any{"error": err.Error()})
+		return nil, trace, err
+	}
+
+	disbursementDate := response[0].DisbursementDate
+	trace.add("disbursement_date", 0, "disbursement date adjusted from the requested date", map[string]any{
+		"requested_date":    params.RequestedDate,
+		"disbursement_date": disbursementDate,
+		"skipped_days":      daysBetween(params.RequestedDate, disbursementDate),
+		"non_business_days": GetNonBusinessDaysBetween(params.RequestedDate, disbursementDate),
+	})
+
+	for _, r := range response {
+		trace.add("discount_factor", r.Installment, fmt.Sprintf("discount factor of due date %d", r.Installment), map[string]any{
+			"due_date":               r.DueDate,
+			"accumulated_days":       r.AccumulatedDays,
+			"days_index":             r.DaysIndex,
+			"accumulated_days_index": r.AccumulatedDaysIndex,
+		})
+	}
+
+	for _, r := range response {
+		unrounded := r.ContractAmount / r.AccumulatedDaysIndex
+		trace.add("installment_amount", r.Installment, "installment amount is the contract amount divided by the accumulated discount factors", map[string]any{
+			"contract_amount":        r.ContractAmount,
+			"accumulated_days_index": r.AccumulatedDaysIndex,
+			"unrounded":              unrounded,
+			"rounded":                r.InstallmentAmount,
+			"rounding_difference":    roundTo(r.InstallmentAmount-unrounded, 10),
+		})
+
+		iterations := equalInstallments(schedule[:r.Installment]).solveIof(params, params.RequestedAmount+r.TacAmount)
+		for k, it := range iterations {
+			trace.add("iof_iteration", r.Installment, fmt.Sprintf("iteration %d of the contract amount, whose IOF depends on it", k+1), map[string]any{
+				"contract_amount":      it.contractAmount,
+				"overall_iof_amount":   it.overallIof,
+				"daily_iof_amount":     it.dailyIof,
+				"total_iof":            it.iof,
+				"next_contract_amount": it.nextContractAmount,
+				"delta":                roundCents(it.nextContractAmount - it.contractAmount),
+			})
+		}
+		settled := iterations[len(iterations)-1]
+		trace.add("iof", r.Installment, "contract amount is the pre-disbursement amount plus IOF", map[string]any{
+			"iterations":              len(iterations),
+			"solved_contract_amount":  settled.nextContractAmount,
+			"solved_total_iof":        settled.iof,
+			"pre_disbursement_amount": r.PreDisbursementAmount,
+			"total_iof":               r.TotalIof,
+			"contract_amount":         r.ContractAmount,
+			"paid_total_iof":          r.PaidTotalIof,
+			"paid_contract_amount":    r.PaidContractAmount,
+		})
+
+		trace.add("rounding", r.Installment, "total amount is the rounded installment amount times the installment count", map[string]any{
+			"installment_amount": r.InstallmentAmount,
+			"total_amount":       r.TotalAmount,
+			"difference":         roundTo(r.TotalAmount-r.InstallmentAmount*float64(r.Installment), 10),
+		})
+
+		trace.add("effective_rates", r.Installment, "effective interest rate and total effective cost", map[string]any{
+			"calculation_basis_for_effective_interest_rate": r.CalculationBasisForEffectiveInterestRate,
+			"eir_monthly": r.EirMonthly,
+			"eir_yearly":  r.EirYearly,
+			"tec_monthly": r.TecMonthly,
+			"tec_yearly":  r.TecYearly,
+		})
+	}
+
+	return response, trace, nil
+}
+
+// Filter returns the steps of the trace with the given name, in order.
+func (t Trace) Filter(step string) []TraceStep {
+	var steps []TraceStep
+	for _, s := range t.Steps {
+		if s.Step == step {
+			steps = append(steps, s)
+		}
+	}
+	return steps
+}
@@ -0,0 +1,62 @@
+package payment_plan_test
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"testing"
+
+	payment_plan "github.com/ParceladoLara/payment-plan-go-sdk"
+	"github.com/ParceladoLara/payment-plan-go-sdk/payment_plantest"
+)
+
+func TestCalculatePaymentPlanWithTrace(t *testing.T) {
+	params := payment_plantest.NewParams().Build()
+
+	plain, err := payment_plan.CalculatePaymentPlan(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+
+	resp, trace, err := payment_plan.CalculatePaymentPlanWithTrace(params)
+	if err != nil {
+		t.Fatalf("Error calculating payment plan: %v", err)
+	}
+
+	payment_plantest.AssertResponsesEqual(t, resp, plain, daysIndexTolerance)
+
+	if len(trace.Filter("disbursement_date")) != 1 {
+		t.Errorf("Expected 1 disbursement_date step, got %d", len(trace.Filter("disbursement_date")))
+	}
+	for _, step := range []string{"discount_factor", "installment_amount", "iof", "rounding", "effective_rates"} {
+		if len(trace.Filter(step)) != len(resp) {
+			t.Errorf("Expected %d %s steps, got %d", len(resp), step, len(trace.Filter(step)))
+		}
+	}
+
+	for i, step := range trace.Filter("installment_amount") {
+		if step.Values["rounded"] != resp[i].InstallmentAmount {
+			t.Errorf("Installment %d: Expected rounded %v, got %v", i+1, resp[i].InstallmentAmount, step.Values["rounded"])
+		}
+	}
+
+	// The contract amount of 1x goes from 7800 to the native 7847.84, whose IOF is 29.82 of overall and 18.02 of daily IOF.
+	var iterations []float64
+	for _, step := range trace.Filter("iof_iteration") {
+		if step.Installment == 1 {
+			iterations = append(iterations, step.Values["contract_amount"].(float64))
+		}
+	}
+	if !slices.Equal(iterations, []float64{7800, 7847.55, 7847.84}) {
+		t.Errorf("Expected the contract amounts 7800, 7847.55 and 7847.84 for 1x, got %v", iterations)
+	}
+	for i, step := range trace.Filter("iof") {
+		if solved := step.Values["solved_total_iof"].(float64); math.Abs(solved-resp[i].TotalIof) > 0.011 {
+			t.Errorf("Installment %d: Expected the solved IOF within a cent of %v, got %v", i+1, resp[i].TotalIof, solved)
+		}
+	}
+
+	if _, err := json.Marshal(trace); err != nil {
+		t.Errorf("Error marshalling trace: %v", err)
+	}
+}
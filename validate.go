@@ -0,0 +1,98 @@
+package payment_plan
+
+import (
+	"fmt"
+	"math"
+)
+
+// fieldRule is the range a numeric field of a request must be in.
+// Fields are named by their JSON name, see JSONSchemaVersion.
+type fieldRule struct {
+	field        string
+	minimum      float64
+	maximum      float64
+	exclusiveMin bool
+}
+
+var noMaximum = math.Inf(1)
+
+// paramsRules are the ranges of the Params fields. They are checked by ValidateParams and published by JSONSchema and OpenAPIComponents.
+var paramsRules = []fieldRule{
+	{field: "requested_amount", minimum: 0, maximum: noMaximum, exclusiveMin: true},
+	{field: "installments", minimum: 1, maximum: noMaximum},
+	{field: "debit_service_percentage", minimum: 0, maximum: 100},
+	{field: "mdr", minimum: 0, maximum: 1},
+	{field: "tac_percentage", minimum: 0, maximum: 1},
+	{field: "iof_overall", minimum: 0, maximum: 1},
+	{field: "iof_percentage", minimum: 0, maximum: 1},
+	{field: "interest_rate", minimum: 0, maximum: noMaximum},
+	{field: "min_installment_amount", minimum: 0, maximum: noMaximum},
+	{field: "max_total_amount", minimum: 0, maximum: noMaximum, exclusiveMin: true},
+}
+
+// downPaymentParamsRules are the ranges of the DownPaymentParams fields, other than the ones of its Params.
+var downPaymentParamsRules = []fieldRule{
+	{field: "requested_amount", minimum: 0, maximum: noMaximum, exclusiveMin: true},
+	{field: "min_installment_amount", minimum: 0, maximum: noMaximum},
+	{field: "installments", minimum: 1, maximum: noMaximum},
+}
+
+func (r fieldRule) check(value float64) error {
+	if math.IsNaN(value) || value < r.minimum || (r.exclusiveMin && value == r.minimum) || value > r.maximum {
+		return fmt.Errorf("%s %v is out of range %s: %w", r.field, value, r, ErrInvalidParams)
+	}
+	return nil
+}
+
+func (r fieldRule) String() string {
+	lower := "["
+	if r.exclusiveMin {
+		lower = "("
+	}
+	if math.IsInf(r.maximum, 1) {
+		return fmt.Sprintf("%s%v, +inf)", lower, r.minimum)
+	}
+	return fmt.Sprintf("%s%v, %v]", lower, r.minimum, r.maximum)
+}
+
+func checkRules(rules []fieldRule, values map[string]float64) error {
+	for _, rule := range rules {
+		if err := rule.check(values[rule.field]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateParams checks the ranges of the params fields before they reach the native library,
+// which only tells that the params are invalid, not which field is.
+func ValidateParams(params Params) error {
+	return checkRules(paramsRules, map[string]float64{
+		"requested_amount":         params.RequestedAmount,
+		"installments":             float64(params.Installments),
+		"debit_service_percentage": float64(params.DebitServicePercentage),
+		"mdr":                      params.Mdr,
+		"tac_percentage":           params.TacPercentage,
+		"iof_overall":              params.IofOverall,
+		"iof_percentage":           params.IofPercentage,
+		"interest_rate":            params.InterestRate,
+		"min_installment_amount":   params.MinInstallmentAmount,
+		"max_total_amount":         params.MaxTotalAmount,
+	})
+}
+
+// ValidateDownPaymentParams checks the ranges of the down payment params fields, including its Params.
+func ValidateDownPaymentParams(params DownPaymentParams) error {
+	err := checkRules(downPaymentParamsRules, map[string]float64{
+		"requested_amount":       params.RequestedAmount,
+		"min_installment_amount": params.MinInstallmentAmount,
+		"installments":           float64(params.Installments),
+	})
+	if err != nil {
+		return err
+	}
+	if err := ValidateParams(params.Params); err != nil {
+		return fmt.Errorf("params: %w", err)
+	}
+	return nil
+}
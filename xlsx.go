@@ -0,0 +1,147 @@
+package payment_plan
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// XLSXOptions configures WriteXLSX.
+type XLSXOptions struct {
+	// Columns are the JSON names of the Response fields to export (e.g. "installment_amount"), DefaultColumns if empty.
+	Columns []string
+	// Locale of the headers, LocaleEnUS if empty. Numbers and dates are stored as values, so the spreadsheet
+	// application presents them in the locale of the reader. Numbers are rounded like in WriteCSV.
+	Locale Locale
+	// Headers overrides the header of the given columns.
+	Headers map[string]string
+	// SheetName is the name of the worksheet, "Plans" if empty.
+	SheetName string
+}
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>
+</Types>`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+</Relationships>`
+	// Style 1 is a date, the only format that isn't general.
+	xlsxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>
+<fills count="1"><fill><patternFill patternType="none"/></fill></fills>
+<borders count="1"><border/></borders>
+<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>
+<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="14" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/></cellXfs>
+</styleSheet>`
+	xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>
+</workbook>`
+)
+
+// WriteXLSX writes a spreadsheet with a header row and one row for each response with the selected columns.
+// The spreadsheet is written with the standard library only, it has a single worksheet with values and no formulas.
+func WriteXLSX(w io.Writer, response []Response, options XLSXOptions) error {
+	columns, err := exportColumns(options.Columns, options.Locale, options.Headers)
+	if err != nil {
+		return err
+	}
+
+	sheetName := options.SheetName
+	if sheetName == "" {
+		sheetName = "Plans"
+	}
+
+	var sheet strings.Builder
+	sheet.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	sheet.WriteString(`<row r="1">`)
+	for i, c := range columns {
+		writeXLSXString(&sheet, cellReference(i, 1), c.header)
+	}
+	sheet.WriteString(`</row>`)
+
+	for j, r := range response {
+		row := j + 2
+		fmt.Fprintf(&sheet, `<row r="%d">`, row)
+		for i, c := range columns {
+			ref := cellReference(i, row)
+			switch v := c.value(r).(type) {
+			case time.Time:
+				fmt.Fprintf(&sheet, `<c r="%s" s="1"><v>%d</v></c>`, ref, excelSerialDate(v))
+			case float64:
+				fmt.Fprintf(&sheet, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(roundTo(v, c.decimals), 'f', -1, 64))
+			default:
+				fmt.Fprintf(&sheet, `<c r="%s"><v>%v</v></c>`, ref, v)
+			}
+		}
+		sheet.WriteString(`</row>`)
+	}
+	sheet.WriteString(`</sheetData></worksheet>`)
+
+	var escapedName strings.Builder
+	if err := xml.EscapeText(&escapedName, []byte(sheetName)); err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRels},
+		{"xl/workbook.xml", fmt.Sprintf(xlsxWorkbook, escapedName.String())},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStyles},
+		{"xl/worksheets/sheet1.xml", sheet.String()},
+	}
+	for _, file := range files {
+		f, err := archive.Create(file.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, file.content); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+func writeXLSXString(sheet *strings.Builder, ref string, value string) {
+	fmt.Fprintf(sheet, `<c r="%s" t="inlineStr"><is><t>`, ref)
+	xml.EscapeText(sheet, []byte(value))
+	sheet.WriteString(`</t></is></c>`)
+}
+
+// cellReference returns the A1 reference of a zero based column and a one based row, e.g. (27, 3) is AB3.
+func cellReference(column int, row int) string {
+	name := ""
+	for column++; column > 0; column = (column - 1) / 26 {
+		name = string(rune('A'+(column-1)%26)) + name
+	}
+	return fmt.Sprintf("%s%d", name, row)
+}
+
+// excelSerialDate returns the number of days since 1899-12-30, which is how spreadsheets store dates.
+func excelSerialDate(t time.Time) int64 {
+	return daysBetween(time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC), t)
+}